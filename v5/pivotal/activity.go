@@ -57,10 +57,35 @@ func newActivitiesService(client *Client) *ActivityService {
 // to get the right results. The response is default sorted in DESCENDING order so
 // leverage the sortOrder variable to control sort order.
 func (service *ActivityService) List(projectID int, sortOrder *string, limit *int, offset *int, occurredBefore *time.Time, occurredAfter *time.Time, sinceVersion *int) ([]*Activity, error) {
+	activityPath := fmt.Sprintf("projects/%v/activity", projectID)
+	return service.list(activityPath, sortOrder, limit, offset, occurredBefore, occurredAfter, sinceVersion)
+}
+
+// ListStory returns all activities of the given story. It accepts the same
+// parameters and behaves the same way as List.
+func (service *ActivityService) ListStory(projectID, storyID int, sortOrder *string, limit *int, offset *int, occurredBefore *time.Time, occurredAfter *time.Time, sinceVersion *int) ([]*Activity, error) {
+	activityPath := fmt.Sprintf("projects/%v/stories/%v/activity", projectID, storyID)
+	return service.list(activityPath, sortOrder, limit, offset, occurredBefore, occurredAfter, sinceVersion)
+}
+
+// ListEpic returns all activities of the given epic. It accepts the same
+// parameters and behaves the same way as List.
+func (service *ActivityService) ListEpic(projectID, epicID int, sortOrder *string, limit *int, offset *int, occurredBefore *time.Time, occurredAfter *time.Time, sinceVersion *int) ([]*Activity, error) {
+	activityPath := fmt.Sprintf("projects/%v/epics/%v/activity", projectID, epicID)
+	return service.list(activityPath, sortOrder, limit, offset, occurredBefore, occurredAfter, sinceVersion)
+}
+
+// ListMine returns all activities performed by the authenticated user across
+// all of their projects. It behaves the same way as List.
+func (service *ActivityService) ListMine(sortOrder *string, limit *int, offset *int, occurredBefore *time.Time, occurredAfter *time.Time, sinceVersion *int) ([]*Activity, error) {
+	return service.list("my/activity", sortOrder, limit, offset, occurredBefore, occurredAfter, sinceVersion)
+}
+
+func (service *ActivityService) list(activityPath string, sortOrder *string, limit *int, offset *int, occurredBefore *time.Time, occurredAfter *time.Time, sinceVersion *int) ([]*Activity, error) {
 	if err := validateSortOrder(sortOrder); err != nil {
 		return nil, err
 	}
-	reqFunc := newActivitiesRequestFunc(service.client, projectID, sortOrder, limit, offset, occurredBefore, occurredAfter, sinceVersion)
+	reqFunc := newActivitiesRequestFunc(service.client, activityPath, sortOrder, limit, offset, occurredBefore, occurredAfter, sinceVersion)
 	cursor, err := newCursor(service.client, reqFunc, 0)
 	if err != nil {
 		return nil, err
@@ -75,9 +100,9 @@ func (service *ActivityService) List(projectID int, sortOrder *string, limit *in
 
 // newActivitiesRequestFunc takes in pointers to a bunch of types, there reason for this is so we can pass in nil values and create a query string accordingly
 // this could be wrapped a different way to accomplish a similar goal but the nil value is the desired behavior
-func newActivitiesRequestFunc(client *Client, projectID int, sortOrder *string, limit *int, offset *int, occurredBefore *time.Time, occurredAfter *time.Time, sinceVersion *int) func() *http.Request {
+func newActivitiesRequestFunc(client *Client, activityPath string, sortOrder *string, limit *int, offset *int, occurredBefore *time.Time, occurredAfter *time.Time, sinceVersion *int) func() *http.Request {
 	return func() *http.Request {
-		activityPath := fmt.Sprintf("projects/%v/activity", projectID)
+		u := activityPath
 		queryParams := url.Values{}
 		if sortOrder != nil {
 			queryParams.Add("sort_order", *sortOrder)
@@ -98,10 +123,10 @@ func newActivitiesRequestFunc(client *Client, projectID int, sortOrder *string,
 			queryParams.Add("since_version", strconv.Itoa(*sinceVersion))
 		}
 		if len(queryParams) > 0 {
-			activityPath += "?"
-			activityPath += queryParams.Encode()
+			u += "?"
+			u += queryParams.Encode()
 		}
-		req, _ := client.NewRequest("GET", activityPath, nil)
+		req, _ := client.NewRequest("GET", u, nil)
 		return req
 	}
 }
@@ -134,10 +159,35 @@ func (c *ActivityCursor) Next() (s *Activity, err error) {
 // Iterate returns a cursor that can be used to iterate over the activities specified
 // by the filter. More stories are fetched on demand as needed.
 func (service *ActivityService) Iterate(projectID int, sortOrder *string, occurredBefore *time.Time, occurredAfter *time.Time, sinceVersion *int) (c *ActivityCursor, err error) {
+	activityPath := fmt.Sprintf("projects/%v/activity", projectID)
+	return service.iterate(activityPath, sortOrder, occurredBefore, occurredAfter, sinceVersion)
+}
+
+// IterateStory returns a cursor that can be used to iterate over the activities
+// of the given story. More activities are fetched on demand as needed.
+func (service *ActivityService) IterateStory(projectID, storyID int, sortOrder *string, occurredBefore *time.Time, occurredAfter *time.Time, sinceVersion *int) (c *ActivityCursor, err error) {
+	activityPath := fmt.Sprintf("projects/%v/stories/%v/activity", projectID, storyID)
+	return service.iterate(activityPath, sortOrder, occurredBefore, occurredAfter, sinceVersion)
+}
+
+// IterateEpic returns a cursor that can be used to iterate over the activities
+// of the given epic. More activities are fetched on demand as needed.
+func (service *ActivityService) IterateEpic(projectID, epicID int, sortOrder *string, occurredBefore *time.Time, occurredAfter *time.Time, sinceVersion *int) (c *ActivityCursor, err error) {
+	activityPath := fmt.Sprintf("projects/%v/epics/%v/activity", projectID, epicID)
+	return service.iterate(activityPath, sortOrder, occurredBefore, occurredAfter, sinceVersion)
+}
+
+// IterateMine returns a cursor that can be used to iterate over the activities
+// performed by the authenticated user. More activities are fetched on demand as needed.
+func (service *ActivityService) IterateMine(sortOrder *string, occurredBefore *time.Time, occurredAfter *time.Time, sinceVersion *int) (c *ActivityCursor, err error) {
+	return service.iterate("my/activity", sortOrder, occurredBefore, occurredAfter, sinceVersion)
+}
+
+func (service *ActivityService) iterate(activityPath string, sortOrder *string, occurredBefore *time.Time, occurredAfter *time.Time, sinceVersion *int) (c *ActivityCursor, err error) {
 	if err = validateSortOrder(sortOrder); err != nil {
 		return nil, err
 	}
-	reqFunc := newActivitiesRequestFunc(service.client, projectID, sortOrder, nil, nil, occurredBefore, occurredAfter, sinceVersion)
+	reqFunc := newActivitiesRequestFunc(service.client, activityPath, sortOrder, nil, nil, occurredBefore, occurredAfter, sinceVersion)
 	cursor, err := newCursor(service.client, reqFunc, PageLimit)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,198 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// DefaultBatchSize is the maximum number of requests sent to the aggregator
+// endpoint at once in case Batch.MaxSize is not set.
+const DefaultBatchSize = 100
+
+// Batch collects GET requests to be executed using the aggregator endpoint,
+// which runs many GET requests in a single round trip.
+//
+// Use the Add* methods to queue requests together with their destinations,
+// then call Do to execute the requests and decode the results.
+type Batch struct {
+	// MaxSize is the maximum number of requests sent to the aggregator at once.
+	// Bigger batches are split automatically.
+	MaxSize int
+
+	client   *Client
+	requests []*batchRequest
+}
+
+type batchRequest struct {
+	url string
+	v   interface{}
+}
+
+// NewBatch returns an empty Batch bound to the Client.
+func (c *Client) NewBatch() *Batch {
+	return &Batch{client: c}
+}
+
+// Len returns the number of requests queued.
+func (b *Batch) Len() int {
+	return len(b.requests)
+}
+
+// Add queues a GET request for urlPath, relative to the client base URL.
+// The result is JSON-decoded into v once the batch is executed.
+func (b *Batch) Add(urlPath string, v interface{}) error {
+	path, err := url.Parse(urlPath)
+	if err != nil {
+		return err
+	}
+	b.add(path, v)
+	return nil
+}
+
+func (b *Batch) add(path *url.URL, v interface{}) {
+	u := b.client.baseURL.ResolveReference(path)
+	b.requests = append(b.requests, &batchRequest{u.RequestURI(), v})
+}
+
+// AddStory queues a request for a Story, see StoryService.Get.
+func (b *Batch) AddStory(projectID, storyID int, v *Story) {
+	b.add(&url.URL{Path: fmt.Sprintf("projects/%v/stories/%v", projectID, storyID)}, v)
+}
+
+// AddStoryTasks queues a request for the Tasks of a Story, see StoryService.ListTasks.
+func (b *Batch) AddStoryTasks(projectID, storyID int, v *[]*Task) {
+	b.add(&url.URL{Path: fmt.Sprintf("projects/%v/stories/%v/tasks", projectID, storyID)}, v)
+}
+
+// AddStoryComments queues a request for the Comments of a Story, see StoryService.ListComments.
+func (b *Batch) AddStoryComments(projectID, storyID int, v *[]*Comment) {
+	b.add(&url.URL{Path: fmt.Sprintf("projects/%v/stories/%v/comments", projectID, storyID)}, v)
+}
+
+// AddStoryOwners queues a request for the owners of a Story, see StoryService.ListOwners.
+func (b *Batch) AddStoryOwners(projectID, storyID int, v *[]*Person) {
+	b.add(&url.URL{Path: fmt.Sprintf("projects/%v/stories/%v/owners", projectID, storyID)}, v)
+}
+
+// AddStoryBlockers queues a request for the Blockers of a Story, see StoryService.ListBlockers.
+func (b *Batch) AddStoryBlockers(projectID, storyID int, v *[]*Blocker) {
+	b.add(&url.URL{Path: fmt.Sprintf("projects/%v/stories/%v/blockers", projectID, storyID)}, v)
+}
+
+// AddEpic queues a request for an Epic, see EpicService.Get.
+func (b *Batch) AddEpic(projectID, epicID int, v *Epic) {
+	b.add(&url.URL{Path: fmt.Sprintf("projects/%v/epics/%v", projectID, epicID)}, v)
+}
+
+// AddProject queues a request for a Project, see ProjectService.Get.
+func (b *Batch) AddProject(projectID int, v *Project) {
+	b.add(&url.URL{Path: fmt.Sprintf("projects/%v", projectID)}, v)
+}
+
+// AddIteration queues a request for an Iteration, see IterationService.Get.
+func (b *Batch) AddIteration(projectID, iterationNumber int, v *Iteration) {
+	b.add(&url.URL{Path: fmt.Sprintf("projects/%v/iterations/%v", projectID, iterationNumber)}, v)
+}
+
+// ErrBatch is returned by Batch.Do when some of the requests failed.
+type ErrBatch struct {
+	// Errors maps the failed URLs to the errors returned by the API.
+	Errors map[string]*Error
+}
+
+// Error implements the Error interface for the ErrBatch struct.
+func (err *ErrBatch) Error() string {
+	urls := make([]string, 0, len(err.Errors))
+	for u := range err.Errors {
+		urls = append(urls, u)
+	}
+	sort.Strings(urls)
+
+	msgs := make([]string, 0, len(urls))
+	for _, u := range urls {
+		msgs = append(msgs, fmt.Sprintf("%v (error = %+v)", u, err.Errors[u]))
+	}
+	return fmt.Sprintf(
+		"%v of the batched requests failed: %v", len(urls), strings.Join(msgs, "; "))
+}
+
+// Do sends the queued requests to the aggregator endpoint and decodes the results.
+//
+// The requests are split into chunks of at most MaxSize requests. In case
+// the aggregator request itself fails, Do returns immediately. Failures of
+// the individual requests are collected and returned as an *ErrBatch once
+// all the chunks are processed.
+func (b *Batch) Do() error {
+	size := b.MaxSize
+	if size <= 0 {
+		size = DefaultBatchSize
+	}
+
+	batchErr := ErrBatch{Errors: make(map[string]*Error)}
+	for start := 0; start < len(b.requests); start += size {
+		end := start + size
+		if end > len(b.requests) {
+			end = len(b.requests)
+		}
+		if err := b.do(b.requests[start:end], &batchErr); err != nil {
+			return err
+		}
+	}
+
+	if len(batchErr.Errors) != 0 {
+		return &batchErr
+	}
+	return nil
+}
+
+func (b *Batch) do(requests []*batchRequest, batchErr *ErrBatch) error {
+	urls := make([]string, 0, len(requests))
+	for _, r := range requests {
+		urls = append(urls, r.url)
+	}
+
+	req, err := b.client.NewRequest("POST", "aggregator", urls)
+	if err != nil {
+		return err
+	}
+
+	var results map[string]json.RawMessage
+	if _, err := b.client.Do(req, &results); err != nil {
+		return err
+	}
+
+	for _, r := range requests {
+		result, ok := results[r.url]
+		if !ok {
+			batchErr.Errors[r.url] = &Error{
+				Code:  "missing_result",
+				Error: "the aggregator returned no result for the request",
+			}
+			continue
+		}
+
+		var kind struct {
+			Kind string `json:"kind"`
+		}
+		if json.Unmarshal(result, &kind) == nil && kind.Kind == "error" {
+			var apiErr Error
+			if err := json.Unmarshal(result, &apiErr); err != nil {
+				return err
+			}
+			batchErr.Errors[r.url] = &apiErr
+			continue
+		}
+
+		if err := json.Unmarshal(result, r.v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
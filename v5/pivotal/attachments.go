@@ -0,0 +1,103 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// FileAttachment is a file uploaded to Pivotal Tracker. Once uploaded,
+// it can be attached to a Comment using Comment.FileAttachments.
+type FileAttachment struct {
+	ID            int        `json:"id,omitempty"`
+	Filename      string     `json:"filename,omitempty"`
+	UploaderID    int        `json:"uploader_id,omitempty"`
+	ContentType   string     `json:"content_type,omitempty"`
+	Size          int        `json:"size,omitempty"`
+	DownloadURL   string     `json:"download_url,omitempty"`
+	Uploaded      bool       `json:"uploaded,omitempty"`
+	Thumbnailable bool       `json:"thumbnailable,omitempty"`
+	Height        int        `json:"height,omitempty"`
+	Width         int        `json:"width,omitempty"`
+	ThumbnailURL  string     `json:"thumbnail_url,omitempty"`
+	BigURL        string     `json:"big_url,omitempty"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	Kind          string     `json:"kind,omitempty"`
+}
+
+// FileAttachmentService wraps the client context for uploading and downloading files.
+type FileAttachmentService struct {
+	client *Client
+}
+
+func newFileAttachmentService(client *Client) *FileAttachmentService {
+	return &FileAttachmentService{client}
+}
+
+// Upload uploads the content read from r into the given project.
+// The returned FileAttachment can be attached to a story or an epic comment.
+func (service *FileAttachmentService) Upload(
+	projectID int,
+	fileName string,
+	contentType string,
+	r io.Reader,
+) (*FileAttachment, *http.Response, error) {
+
+	if fileName == "" {
+		return nil, nil, &ErrFieldNotSet{"filename"}
+	}
+
+	u := fmt.Sprintf("projects/%v/uploads", projectID)
+	req, err := service.client.NewMultipartRequest("POST", u, "file", fileName, contentType, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var attachment FileAttachment
+	resp, err := service.client.Do(req, &attachment)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &attachment, resp, nil
+}
+
+// Download streams the content of the given attachment into w.
+func (service *FileAttachmentService) Download(attachment *FileAttachment, w io.Writer) (*http.Response, error) {
+	if attachment.DownloadURL == "" {
+		return nil, &ErrFieldNotSet{"download_url"}
+	}
+	return service.download(attachment.DownloadURL, w)
+}
+
+// DownloadThumbnail streams the thumbnail of the given attachment into w.
+// Thumbnails are only available for attachments that are thumbnailable.
+func (service *FileAttachmentService) DownloadThumbnail(attachment *FileAttachment, w io.Writer) (*http.Response, error) {
+	if attachment.ThumbnailURL == "" {
+		return nil, &ErrFieldNotSet{"thumbnail_url"}
+	}
+	return service.download(attachment.ThumbnailURL, w)
+}
+
+// DownloadBig streams the large preview of the given attachment into w.
+// Large previews are only available for attachments that are thumbnailable.
+func (service *FileAttachmentService) DownloadBig(attachment *FileAttachment, w io.Writer) (*http.Response, error) {
+	if attachment.BigURL == "" {
+		return nil, &ErrFieldNotSet{"big_url"}
+	}
+	return service.download(attachment.BigURL, w)
+}
+
+func (service *FileAttachmentService) download(u string, w io.Writer) (*http.Response, error) {
+	req, err := service.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.client.doStream(req, w)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFileAttachmentServiceDownloadRedirect(t *testing.T) {
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token := r.Header.Get("X-TrackerToken"); token != "" {
+			t.Errorf("X-TrackerToken leaked to the storage host: %q", token)
+		}
+		if ct := r.Header.Get("Content-Type"); ct != "" {
+			t.Errorf("Content-Type sent to the storage host: %q", ct)
+		}
+		w.Write([]byte("content"))
+	}))
+	defer storage.Close()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if token := r.Header.Get("X-TrackerToken"); token != testToken {
+			t.Errorf("X-TrackerToken = %q, want %q", token, testToken)
+		}
+		http.Redirect(w, r, storage.URL+"/file", http.StatusFound)
+	})
+
+	var buf bytes.Buffer
+	attachment := &FileAttachment{DownloadURL: "file_attachments/1/download"}
+	if _, err := client.FileAttachments.Download(attachment, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "content" {
+		t.Errorf("downloaded %q, want %q", got, "content")
+	}
+}
+
+func TestFileAttachmentServiceDownloadForeignURL(t *testing.T) {
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token := r.Header.Get("X-TrackerToken"); token != "" {
+			t.Errorf("X-TrackerToken leaked to the storage host: %q", token)
+		}
+		if ct := r.Header.Get("Content-Type"); ct != "" {
+			t.Errorf("Content-Type sent to the storage host: %q", ct)
+		}
+		w.Write([]byte("thumbnail"))
+	}))
+	defer storage.Close()
+
+	client := newTestClient(t, testRoutes(t, nil))
+
+	var buf bytes.Buffer
+	attachment := &FileAttachment{ThumbnailURL: storage.URL + "/thumb"}
+	if _, err := client.FileAttachments.DownloadThumbnail(attachment, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "thumbnail" {
+		t.Errorf("downloaded %q, want %q", got, "thumbnail")
+	}
+}
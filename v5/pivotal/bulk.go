@@ -0,0 +1,113 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+// DefaultBulkConcurrency is the number of requests running in parallel
+// during a bulk operation in case BulkOptions.Concurrency is not set.
+const DefaultBulkConcurrency = 8
+
+// BulkOptions controls the way bulk operations are executed.
+type BulkOptions struct {
+	// Concurrency is the maximum number of requests running in parallel.
+	Concurrency int
+
+	// DryRun makes the operation only fetch the affected stories
+	// without changing anything.
+	DryRun bool
+}
+
+// BulkUpdate applies the same StoryRequest patch to all the given stories.
+//
+// The requests are sent concurrently, at most opts.Concurrency at once.
+// In case opts.DryRun is set, the stories are only fetched, which verifies
+// they exist and are accessible, and the estimate in the patch is checked
+// against every story, but the stories are not updated. The patch must be
+// set unless opts.DryRun is set.
+//
+// The patch itself is validated before any request is sent, an invalid patch
+// is reported by returning the validation error directly.
+//
+// The returned slice is in the same order as storyIDs, containing nil for
+// the stories that failed. In case any story fails, the error returned
+// is an *ErrBulk listing all the failures.
+func (service *StoryService) BulkUpdate(
+	projectID int,
+	storyIDs []int,
+	patch *StoryRequest,
+	opts *BulkOptions,
+) ([]*Story, error) {
+
+	var options BulkOptions
+	if opts != nil {
+		options = *opts
+	}
+	if patch == nil {
+		if !options.DryRun {
+			return nil, &ErrFieldNotSet{"story"}
+		}
+	} else if err := patch.validate(); err != nil {
+		return nil, err
+	}
+
+	return service.bulk(storyIDs, options.Concurrency, func(storyID int) (*Story, error) {
+		if options.DryRun {
+			return service.dryRunUpdate(projectID, storyID, patch)
+		}
+		story, _, err := service.Update(projectID, storyID, patch)
+		return story, err
+	})
+}
+
+// dryRunUpdate fetches the story and checks the estimate in the patch
+// the same way Update does, without updating the story.
+func (service *StoryService) dryRunUpdate(projectID, storyID int, patch *StoryRequest) (*Story, error) {
+	story, _, err := service.Get(projectID, storyID)
+	if err != nil {
+		return nil, err
+	}
+	if patch == nil || patch.Estimate == nil {
+		return story, nil
+	}
+
+	storyType := story.Type
+	if patch.Type != nil {
+		storyType = *patch.Type
+	}
+	if err := service.checkEstimate(projectID, storyType, patch.Estimate); err != nil {
+		return nil, err
+	}
+	return story, nil
+}
+
+// bulk calls fn for every story ID, running at most concurrency calls at once.
+func (service *StoryService) bulk(
+	storyIDs []int,
+	concurrency int,
+	fn func(storyID int) (*Story, error),
+) ([]*Story, error) {
+
+	if concurrency <= 0 {
+		concurrency = DefaultBulkConcurrency
+	}
+
+	var (
+		stories = make([]*Story, len(storyIDs))
+		errs    = make([]error, len(storyIDs))
+	)
+	parallel(len(storyIDs), concurrency, func(i int) {
+		stories[i], errs[i] = fn(storyIDs[i])
+	})
+
+	var bulkErr ErrBulk
+	for i, err := range errs {
+		if err != nil {
+			bulkErr.Errors = append(bulkErr.Errors, &ErrStory{storyIDs[i], err})
+		}
+	}
+	if len(bulkErr.Errors) != 0 {
+		return stories, &bulkErr
+	}
+	return stories, nil
+}
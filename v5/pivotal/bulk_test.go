@@ -0,0 +1,45 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestStoryServiceBulkUpdateInvalidPatch(t *testing.T) {
+	client := newTestClient(t, testRoutes(t, nil))
+
+	state := StoryState("finsihed")
+	for _, dryRun := range []bool{false, true} {
+		_, err := client.Stories.BulkUpdate(1, []int{2, 3}, &StoryRequest{State: &state}, &BulkOptions{DryRun: dryRun})
+		if _, ok := err.(*ErrInvalidValue); !ok {
+			t.Errorf("DryRun %v: expected an *ErrInvalidValue, got %v", dryRun, err)
+		}
+	}
+}
+
+func TestStoryServiceBulkUpdateDryRunEstimate(t *testing.T) {
+	client := newTestClient(t, testRoutes(t, map[string]http.HandlerFunc{
+		"GET /projects/1":           respondJSON(&Project{ID: 1, PointScale: "0,1,2,3"}),
+		"GET /projects/1/stories/2": respondJSON(&Story{ID: 2, Type: StoryTypeFeature}),
+		"GET /projects/1/stories/3": respondJSON(&Story{ID: 3, Type: StoryTypeRelease}),
+	}))
+
+	stories, err := client.Stories.BulkUpdate(1, []int{2, 3}, &StoryRequest{Estimate: Float64(2)}, &BulkOptions{DryRun: true})
+	bulkErr, ok := err.(*ErrBulk)
+	if !ok {
+		t.Fatalf("expected an *ErrBulk, got %v", err)
+	}
+	if len(bulkErr.Errors) != 1 || bulkErr.Errors[0].StoryID != 3 {
+		t.Errorf("expected only story 3 to fail, got %v", bulkErr)
+	}
+	if _, ok := bulkErr.Errors[0].Err.(*ErrInvalidEstimate); !ok {
+		t.Errorf("expected an *ErrInvalidEstimate, got %v", bulkErr.Errors[0].Err)
+	}
+	if stories[0] == nil || stories[0].ID != 2 || stories[1] != nil {
+		t.Errorf("unexpected stories returned: %v", stories)
+	}
+}
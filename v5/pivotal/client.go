@@ -8,8 +8,13 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"net/url"
+	"strings"
 )
 
 const (
@@ -57,6 +62,27 @@ type Client struct {
 
 	// Epic Service
 	Epic *EpicService
+
+	// File attachment service
+	FileAttachments *FileAttachmentService
+
+	// Review service
+	Reviews *ReviewService
+
+	// Source commit service
+	SourceCommits *SourceCommitService
+
+	// Search service
+	Search *SearchService
+
+	// Workspace service
+	Workspaces *WorkspaceService
+
+	// Notification service
+	Notifications *NotificationService
+
+	// Release service
+	Releases *ReleaseService
 }
 
 // NewClient takes a Pivotal Tracker API Token (created from the project settings) and
@@ -76,6 +102,13 @@ func NewClient(apiToken string) *Client {
 	client.Iterations = newIterationService(client)
 	client.Activity = newActivitiesService(client)
 	client.Epic = newEpicService(client)
+	client.FileAttachments = newFileAttachmentService(client)
+	client.Reviews = newReviewService(client)
+	client.SourceCommits = newSourceCommitService(client)
+	client.Search = newSearchService(client)
+	client.Workspaces = newWorkspaceService(client)
+	client.Notifications = newNotificationService(client)
+	client.Releases = newReleaseService(client)
 	return client
 }
 
@@ -101,13 +134,6 @@ func (c *Client) SetUserAgent(agent string) {
 
 // NewRequest takes an HTTP request definition and wraps it with the Client context.
 func (c *Client) NewRequest(method, urlPath string, body interface{}) (*http.Request, error) {
-	path, err := url.Parse(urlPath)
-	if err != nil {
-		return nil, err
-	}
-
-	u := c.baseURL.ResolveReference(path)
-
 	buf := new(bytes.Buffer)
 	if body != nil {
 		if err := json.NewEncoder(buf).Encode(body); err != nil {
@@ -115,12 +141,64 @@ func (c *Client) NewRequest(method, urlPath string, body interface{}) (*http.Req
 		}
 	}
 
-	req, err := http.NewRequest(method, u.String(), buf)
+	return c.newRequest(method, urlPath, buf, "application/json")
+}
+
+// NewMultipartRequest creates a multipart/form-data request containing a single
+// file part. The file content is read from r, fileName and contentType are used
+// to describe the part. In case contentType is empty, application/octet-stream is used.
+func (c *Client) NewMultipartRequest(
+	method string,
+	urlPath string,
+	fieldName string,
+	fileName string,
+	contentType string,
+	r io.Reader,
+) (*http.Request, error) {
+
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	buf := new(bytes.Buffer)
+	writer := multipart.NewWriter(buf)
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(
+		`form-data; name="%s"; filename="%s"`,
+		quoteEscaper.Replace(fieldName), quoteEscaper.Replace(fileName)))
+	header.Set("Content-Type", contentType)
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return c.newRequest(method, urlPath, buf, writer.FormDataContentType())
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func (c *Client) newRequest(method, urlPath string, body io.Reader, contentType string) (*http.Request, error) {
+	path, err := url.Parse(urlPath)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	u := c.baseURL.ResolveReference(path)
+
+	req, err := http.NewRequest(method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", contentType)
 	req.Header.Set("User-Agent", c.userAgent)
 	req.Header.Set("X-TrackerToken", c.token)
 	return req, nil
@@ -135,16 +213,8 @@ func (c *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
 
 	defer resp.Body.Close()
 
-	if resp.StatusCode > 299 {
-		var errObject Error
-		if err := json.NewDecoder(resp.Body).Decode(&errObject); err != nil {
-			return resp, &ErrAPI{Response: resp}
-		}
-
-		return resp, &ErrAPI{
-			Response: resp,
-			Err:      &errObject,
-		}
+	if err := checkResponse(resp); err != nil {
+		return resp, err
 	}
 
 	if v != nil {
@@ -153,3 +223,73 @@ func (c *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
 
 	return resp, err
 }
+
+// doStream executes the request like Do, but the raw response body is copied into w.
+//
+// The request may point to, or be redirected to, external storage, so the API
+// token and the JSON Content-Type are removed from any request that is not
+// sent to the API host.
+func (c *Client) doStream(req *http.Request, w io.Writer) (*http.Response, error) {
+	if !c.isAPIHost(req.URL) {
+		stripAPIHeaders(req)
+	}
+
+	client := *c.client
+	checkRedirect := client.CheckRedirect
+	client.CheckRedirect = func(redirect *http.Request, via []*http.Request) error {
+		if !c.isAPIHost(redirect.URL) {
+			stripAPIHeaders(redirect)
+		}
+		if checkRedirect != nil {
+			return checkRedirect(redirect, via)
+		}
+		// Keep the default policy of http.Client.
+		if len(via) >= 10 {
+			return errors.New("stopped after 10 redirects")
+		}
+		return nil
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp); err != nil {
+		return resp, err
+	}
+
+	_, err = io.Copy(w, resp.Body)
+	return resp, err
+}
+
+// isAPIHost returns true in case u points to the host of the API base URL
+// using the same scheme.
+func (c *Client) isAPIHost(u *url.URL) bool {
+	return u.Scheme == c.baseURL.Scheme && u.Host == c.baseURL.Host
+}
+
+// stripAPIHeaders removes the headers meant for the API only from the request.
+func stripAPIHeaders(req *http.Request) {
+	req.Header.Del("X-TrackerToken")
+	req.Header.Del("Content-Type")
+}
+
+// checkResponse turns the error responses of the API into an *ErrAPI.
+func checkResponse(resp *http.Response) error {
+	if resp.StatusCode <= 299 {
+		return nil
+	}
+
+	var errObject Error
+	if err := json.NewDecoder(resp.Body).Decode(&errObject); err != nil {
+		return &ErrAPI{Response: resp}
+	}
+
+	return &ErrAPI{
+		Response: resp,
+		Err:      &errObject,
+	}
+}
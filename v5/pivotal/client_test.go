@@ -0,0 +1,51 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// testToken is the API token used by the clients returned by newTestClient.
+const testToken = "secret-token"
+
+// newTestClient returns a Client sending its requests to a test server
+// running handler. The server is closed once the test finishes.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewClient(testToken)
+	if err := client.SetBaseURL(server.URL + "/"); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// testRoutes returns a handler dispatching the requests by "METHOD /path".
+// Any other request fails the test.
+func testRoutes(t *testing.T, routes map[string]http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handler, ok := routes[r.Method+" "+r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request: %v %v", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// respondJSON returns a handler responding with v encoded as JSON.
+func respondJSON(v interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(v)
+	}
+}
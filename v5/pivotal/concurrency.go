@@ -0,0 +1,30 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"sync"
+)
+
+// parallel calls fn for every index in [0, n), running at most concurrency
+// calls at once, and waits for all of them to return.
+func parallel(n, concurrency int, fn func(i int)) {
+	var (
+		sem = make(chan struct{}, concurrency)
+		wg  sync.WaitGroup
+	)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
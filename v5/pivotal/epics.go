@@ -9,18 +9,25 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 )
 
 // Epic is the primary data object for the epic service.
+//
+// The nested Comments and Followers are only set when requested
+// using a FieldSelector, see ExpandedEpicFields.
 type Epic struct {
 	ID          int        `json:"id,omitempty"`
 	ProjectID   int        `json:"project_id,omitempty"`
 	Name        string     `json:"name,omitempty"`
 	LabelID     int        `json:"label_id,omitempty"`
+	Label       *Label     `json:"label,omitempty"`
 	Description string     `json:"description,omitempty"`
 	CommentIDs  []int      `json:"comment_ids,omitempty"`
+	Comments    []*Comment `json:"comments,omitempty"`
 	FollowerIDs []int      `json:"follower_ids,omitempty"`
+	Followers   []*Person  `json:"followers,omitempty"`
 	CreatedAt   *time.Time `json:"created_at,omitempty"`
 	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
 	AfterID     int        `json:"after_id,omitempty"`
@@ -63,7 +70,13 @@ func newEpicService(client *Client) *EpicService {
 // is not always sorted when using a filter, this approach is required to get
 // the right data. Not sure whether this is a bug or a feature.
 func (service *EpicService) List(projectID int, filter string) ([]*Epic, error) {
-	reqFunc := newEpicsRequestFunc(service.client, projectID, filter)
+	return service.ListWithFields(projectID, filter, nil)
+}
+
+// ListWithFields is the same as List, but the epics returned contain
+// only the fields selected, including nested resources.
+func (service *EpicService) ListWithFields(projectID int, filter string, fields *FieldSelector) ([]*Epic, error) {
+	reqFunc := newEpicsRequestFunc(service.client, projectID, filter, fields)
 	cursor, err := newCursor(service.client, reqFunc, 0)
 	if err != nil {
 		return nil, err
@@ -76,11 +89,18 @@ func (service *EpicService) List(projectID int, filter string) ([]*Epic, error)
 	return epics, nil
 }
 
-func newEpicsRequestFunc(client *Client, projectID int, filter string) func() *http.Request {
+func newEpicsRequestFunc(client *Client, projectID int, filter string, fields *FieldSelector) func() *http.Request {
 	return func() *http.Request {
 		u := fmt.Sprintf("projects/%v/epics", projectID)
+		queryParams := url.Values{}
 		if filter != "" {
-			u += "?filter=" + url.QueryEscape(filter)
+			queryParams.Add("filter", filter)
+		}
+		if s := fields.String(); s != "" {
+			queryParams.Add("fields", s)
+		}
+		if len(queryParams) > 0 {
+			u += "?" + queryParams.Encode()
 		}
 		req, _ := client.NewRequest("GET", u, nil)
 		return req
@@ -115,7 +135,13 @@ func (c *EpicCursor) Next() (e *Epic, err error) {
 // Iterate returns a cursor that can be used to iterate over the epics specified
 // by the filter. More epics are fetched on demand as needed.
 func (service *EpicService) Iterate(projectID int, filter string) (c *EpicCursor, err error) {
-	reqFunc := newEpicsRequestFunc(service.client, projectID, filter)
+	return service.IterateWithFields(projectID, filter, nil)
+}
+
+// IterateWithFields is the same as Iterate, but the epics returned contain
+// only the fields selected, including nested resources.
+func (service *EpicService) IterateWithFields(projectID int, filter string, fields *FieldSelector) (c *EpicCursor, err error) {
+	reqFunc := newEpicsRequestFunc(service.client, projectID, filter, fields)
 	cursor, err := newCursor(service.client, reqFunc, PageLimit)
 	if err != nil {
 		return nil, err
@@ -151,7 +177,13 @@ func (service *EpicService) Create(projectID int, epic *EpicRequest) (*Epic, *ht
 
 // Get is returns an Epic by ID.
 func (service *EpicService) Get(projectID, epicID int) (*Epic, *http.Response, error) {
-	u := fmt.Sprintf("projects/%v/epics/%v", projectID, epicID)
+	return service.GetWithFields(projectID, epicID, nil)
+}
+
+// GetWithFields is the same as Get, but the epic returned contains
+// only the fields selected, including nested resources.
+func (service *EpicService) GetWithFields(projectID, epicID int, fields *FieldSelector) (*Epic, *http.Response, error) {
+	u := withFields(fmt.Sprintf("projects/%v/epics/%v", projectID, epicID), fields)
 	req, err := service.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -166,9 +198,44 @@ func (service *EpicService) Get(projectID, epicID int) (*Epic, *http.Response, e
 	return &epic, resp, err
 }
 
+// GetByID returns an Epic by the epic ID only.
+func (service *EpicService) GetByID(epicID int) (*Epic, *http.Response, error) {
+	return service.GetByIDWithFields(epicID, nil)
+}
+
+// GetByIDWithFields is the same as GetByID, but the epic returned contains
+// only the fields selected, including nested resources.
+func (service *EpicService) GetByIDWithFields(epicID int, fields *FieldSelector) (*Epic, *http.Response, error) {
+	u := withFields(fmt.Sprintf("epics/%v", epicID), fields)
+	req, err := service.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var epic Epic
+	resp, err := service.client.Do(req, &epic)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &epic, resp, err
+}
+
+// ExpandedEpicFields returns a FieldSelector selecting the default epic fields
+// together with the label, the followers and the comments.
+func ExpandedEpicFields() *FieldSelector {
+	return Fields(FieldsDefault, "label", "followers").
+		Nested("comments", Fields(FieldsDefault, "person", "file_attachments"))
+}
+
+// GetExpanded returns an Epic including its nested resources, see ExpandedEpicFields.
+func (service *EpicService) GetExpanded(projectID, epicID int) (*Epic, *http.Response, error) {
+	return service.GetWithFields(projectID, epicID, ExpandedEpicFields())
+}
+
 // Update is will update an Epic with an EpicRequest.
 func (service *EpicService) Update(projectID, epicID int, epic *EpicRequest) (*Epic, *http.Response, error) {
-	u := fmt.Sprintf("projects/%v/stories/%v", projectID, epicID)
+	u := fmt.Sprintf("projects/%v/epics/%v", projectID, epicID)
 	req, err := service.client.NewRequest("PUT", u, epic)
 	if err != nil {
 		return nil, nil, err
@@ -181,5 +248,112 @@ func (service *EpicService) Update(projectID, epicID int, epic *EpicRequest) (*E
 	}
 
 	return &updatedEpic, resp, err
+}
+
+// Delete will remove an Epic. The stories of the epic are not deleted,
+// only the epic and its label are removed.
+func (service *EpicService) Delete(projectID, epicID int) (*http.Response, error) {
+	u := fmt.Sprintf("projects/%v/epics/%v", projectID, epicID)
+	req, err := service.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.client.Do(req, nil)
+}
+
+// ListStories returns the stories belonging to an Epic, i.e. the stories
+// labeled with the epic label.
+func (service *EpicService) ListStories(projectID, epicID int) ([]*Story, error) {
+	epic, _, err := service.GetWithFields(projectID, epicID, Fields(FieldsDefault, "label"))
+	if err != nil {
+		return nil, err
+	}
+	if epic.Label == nil || epic.Label.Name == "" {
+		return nil, &ErrFieldNotSet{"label"}
+	}
+
+	return service.client.Stories.List(projectID, epicLabelFilter(epic.Label.Name))
+}
+
+// epicLabelFilter returns the story filter matching the stories with the given label.
+func epicLabelFilter(labelName string) string {
+	return fmt.Sprintf(`label:"%v"`, strings.Replace(labelName, `"`, `\"`, -1))
+}
+
+// AddComment will take a Comment object and attach it to an Epic.
+func (service *EpicService) AddComment(
+	projectID int,
+	epicID int,
+	comment *Comment,
+) (*Comment, *http.Response, error) {
+
+	u := fmt.Sprintf("projects/%v/epics/%v/comments", projectID, epicID)
+	req, err := service.client.NewRequest("POST", u, comment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var newComment Comment
+	resp, err := service.client.Do(req, &newComment)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &newComment, resp, nil
+}
+
+// ListComments returns the list of Comments in an Epic.
+func (service *EpicService) ListComments(
+	projectID int,
+	epicID int,
+) ([]*Comment, *http.Response, error) {
+
+	u := fmt.Sprintf("projects/%v/epics/%v/comments", projectID, epicID)
+	req, err := service.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var comments []*Comment
+	resp, err := service.client.Do(req, &comments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return comments, resp, nil
+}
+
+// UpdateComment will change the text or the attachments of an existing Comment.
+func (service *EpicService) UpdateComment(
+	projectID int,
+	epicID int,
+	commentID int,
+	comment *Comment,
+) (*Comment, *http.Response, error) {
+
+	u := fmt.Sprintf("projects/%v/epics/%v/comments/%v", projectID, epicID, commentID)
+	req, err := service.client.NewRequest("PUT", u, comment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updatedComment Comment
+	resp, err := service.client.Do(req, &updatedComment)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &updatedComment, resp, nil
+}
+
+// DeleteComment will remove a Comment from an Epic.
+func (service *EpicService) DeleteComment(projectID, epicID, commentID int) (*http.Response, error) {
+	u := fmt.Sprintf("projects/%v/epics/%v/comments/%v", projectID, epicID, commentID)
+	req, err := service.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
 
+	return service.client.Do(req, nil)
 }
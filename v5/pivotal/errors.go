@@ -7,6 +7,7 @@ package pivotal
 import (
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 // Error is the Pivotal Tracker error response API struct
@@ -48,3 +49,100 @@ type ErrFieldNotSet struct {
 func (err *ErrFieldNotSet) Error() string {
 	return fmt.Sprintf("Required field '%s' is not set", err.fieldName)
 }
+
+// ErrStory wraps an error that occurred while processing a single story
+// as part of a bulk operation.
+type ErrStory struct {
+	StoryID int
+	Err     error
+}
+
+// Error implements the Error interface for the ErrStory struct.
+func (err *ErrStory) Error() string {
+	return fmt.Sprintf("story %v: %v", err.StoryID, err.Err)
+}
+
+// ErrBulk aggregates the per-story errors of a bulk operation.
+type ErrBulk struct {
+	Errors []*ErrStory
+}
+
+// Error implements the Error interface for the ErrBulk struct.
+func (err *ErrBulk) Error() string {
+	msgs := make([]string, 0, len(err.Errors))
+	for _, e := range err.Errors {
+		msgs = append(msgs, e.Error())
+	}
+	return fmt.Sprintf(
+		"%v of the stories failed: %v", len(err.Errors), strings.Join(msgs, "; "))
+}
+
+// ErrProject wraps an error that occurred while processing a single project
+// as part of a multi-project operation.
+type ErrProject struct {
+	ProjectID int
+	Err       error
+}
+
+// Error implements the Error interface for the ErrProject struct.
+func (err *ErrProject) Error() string {
+	return fmt.Sprintf("project %v: %v", err.ProjectID, err.Err)
+}
+
+// ErrMultiProject aggregates the per-project errors of a multi-project operation.
+type ErrMultiProject struct {
+	Errors []*ErrProject
+}
+
+// Error implements the Error interface for the ErrMultiProject struct.
+func (err *ErrMultiProject) Error() string {
+	msgs := make([]string, 0, len(err.Errors))
+	for _, e := range err.Errors {
+		msgs = append(msgs, e.Error())
+	}
+	return fmt.Sprintf(
+		"%v of the projects failed: %v", len(err.Errors), strings.Join(msgs, "; "))
+}
+
+// ErrInvalidValue is returned when a field is set to a value
+// that is not accepted by Pivotal Tracker.
+type ErrInvalidValue struct {
+	fieldName string
+	value     interface{}
+}
+
+// Error implements the Error interface for the ErrInvalidValue struct.
+func (err *ErrInvalidValue) Error() string {
+	return fmt.Sprintf("Field '%s' has invalid value '%v'", err.fieldName, err.value)
+}
+
+// ErrInvalidTransition is returned when a story cannot move
+// from one state to another.
+type ErrInvalidTransition struct {
+	Type StoryType
+	From StoryState
+	To   StoryState
+}
+
+// Error implements the Error interface for the ErrInvalidTransition struct.
+func (err *ErrInvalidTransition) Error() string {
+	return fmt.Sprintf(
+		"A %s cannot transition from '%s' to '%s'", err.Type, err.From, err.To)
+}
+
+// ErrInvalidEstimate is returned when an estimate is not allowed by the project
+// settings. Suggestion is the nearest valid estimate, in case there is any.
+type ErrInvalidEstimate struct {
+	Estimate   float64
+	Suggestion *float64
+	Reason     string
+}
+
+// Error implements the Error interface for the ErrInvalidEstimate struct.
+func (err *ErrInvalidEstimate) Error() string {
+	if err.Suggestion != nil {
+		return fmt.Sprintf("Estimate %v is invalid: %s (nearest valid estimate is %v)",
+			err.Estimate, err.Reason, *err.Suggestion)
+	}
+	return fmt.Sprintf("Estimate %v is invalid: %s", err.Estimate, err.Reason)
+}
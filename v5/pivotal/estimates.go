@@ -0,0 +1,141 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PointScale is the list of estimates allowed in a project, sorted ascending.
+type PointScale []float64
+
+// ParsePointScale parses the Project.PointScale string, e.g. "0,1,2,3".
+func ParsePointScale(s string) (PointScale, error) {
+	var scale PointScale
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			return nil, fmt.Errorf("pivotal.ParsePointScale: invalid point scale: %s", s)
+		}
+		scale = append(scale, v)
+	}
+	sort.Float64s(scale)
+	return scale, nil
+}
+
+// Scale returns the parsed point scale of the project.
+func (project *Project) Scale() (PointScale, error) {
+	return ParsePointScale(project.PointScale)
+}
+
+// Contains returns true in case the estimate is part of the scale.
+func (scale PointScale) Contains(estimate float64) bool {
+	for _, v := range scale {
+		if v == estimate {
+			return true
+		}
+	}
+	return false
+}
+
+// Nearest returns the estimate from the scale closest to the given estimate.
+// The higher estimate wins a tie. The second value is false for an empty scale.
+func (scale PointScale) Nearest(estimate float64) (float64, bool) {
+	if len(scale) == 0 {
+		return 0, false
+	}
+	nearest := scale[0]
+	for _, v := range scale[1:] {
+		if math.Abs(v-estimate) <= math.Abs(nearest-estimate) {
+			nearest = v
+		}
+	}
+	return nearest, true
+}
+
+// ValidateEstimate checks whether a story of the given type can be estimated
+// using the given estimate in the project. A nil estimate is always valid.
+func ValidateEstimate(project *Project, storyType StoryType, estimate *float64) error {
+	if estimate == nil {
+		return nil
+	}
+
+	switch {
+	case storyType == StoryTypeRelease:
+		return &ErrInvalidEstimate{Estimate: *estimate, Reason: "releases cannot be estimated"}
+	case (storyType == StoryTypeBug || storyType == StoryTypeChore) && !project.BugsAndChoresAreEstimatable:
+		return &ErrInvalidEstimate{Estimate: *estimate, Reason: "bugs and chores are not estimatable in the project"}
+	}
+
+	scale, err := project.Scale()
+	if err != nil {
+		return err
+	}
+	if scale.Contains(*estimate) {
+		return nil
+	}
+
+	invalid := &ErrInvalidEstimate{Estimate: *estimate, Reason: "not in the project point scale"}
+	if nearest, ok := scale.Nearest(*estimate); ok {
+		invalid.Suggestion = &nearest
+	}
+	return invalid
+}
+
+// validateEstimate checks the estimate in the request against the cached
+// project settings. In case the estimate is rejected using cached settings,
+// the settings are reloaded and the estimate is checked once again, so that
+// changes made since the settings were cached are taken into account.
+//
+// On Update, the story is fetched to find out its type unless the type
+// is part of the request. On Create, the story is a feature by default.
+func (service *StoryService) validateEstimate(projectID, storyID int, story *StoryRequest) error {
+	if story.Estimate == nil {
+		return nil
+	}
+
+	storyType := StoryTypeFeature
+	switch {
+	case story.Type != nil:
+		storyType = *story.Type
+	case storyID != 0:
+		current, _, err := service.Get(projectID, storyID)
+		if err != nil {
+			return err
+		}
+		storyType = current.Type
+	}
+
+	return service.checkEstimate(projectID, storyType, story.Estimate)
+}
+
+// checkEstimate checks the estimate of a story of the given type
+// against the cached project settings, see validateEstimate.
+func (service *StoryService) checkEstimate(projectID int, storyType StoryType, estimate *float64) error {
+	project, cached, err := service.client.Projects.getCached(projectID)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateEstimate(project, storyType, estimate)
+	if err == nil || !cached {
+		return err
+	}
+
+	service.client.Projects.Invalidate(projectID)
+	project, _, err = service.client.Projects.getCached(projectID)
+	if err != nil {
+		return err
+	}
+	return ValidateEstimate(project, storyType, estimate)
+}
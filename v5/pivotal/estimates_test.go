@@ -0,0 +1,159 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"net/http"
+	"reflect"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParsePointScale(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    PointScale
+		wantErr bool
+	}{
+		{input: "0,1,2,3", want: PointScale{0, 1, 2, 3}},
+		{input: "0,1,2,4,8", want: PointScale{0, 1, 2, 4, 8}},
+		{input: " 3, 0 ,1,2 ", want: PointScale{0, 1, 2, 3}},
+		{input: "0,0.5,1,1.5", want: PointScale{0, 0.5, 1, 1.5}},
+		{input: "1,,2,", want: PointScale{1, 2}},
+		{input: ""},
+		{input: "0,1,x", wantErr: true},
+	}
+
+	for _, test := range tests {
+		got, err := ParsePointScale(test.input)
+		if (err != nil) != test.wantErr {
+			t.Errorf("ParsePointScale(%q) error = %v, wantErr %v", test.input, err, test.wantErr)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ParsePointScale(%q) = %v, want %v", test.input, got, test.want)
+		}
+	}
+}
+
+func TestPointScaleNearest(t *testing.T) {
+	tests := []struct {
+		scale    PointScale
+		estimate float64
+		want     float64
+		wantOK   bool
+	}{
+		{PointScale{0, 1, 2, 3}, 2, 2, true},
+		{PointScale{0, 1, 2, 3}, 7, 3, true},
+		{PointScale{0, 1, 2, 3}, -1, 0, true},
+		{PointScale{0, 1, 2, 4, 8}, 3, 4, true},
+		{PointScale{0, 1, 2, 4, 8}, 5, 4, true},
+		{PointScale{0, 1, 2, 4, 8}, 6, 8, true},
+		{PointScale{0, 0.5, 1}, 0.7, 0.5, true},
+		{nil, 1, 0, false},
+	}
+
+	for _, test := range tests {
+		got, ok := test.scale.Nearest(test.estimate)
+		if got != test.want || ok != test.wantOK {
+			t.Errorf("%v.Nearest(%v) = %v, %v, want %v, %v",
+				test.scale, test.estimate, got, ok, test.want, test.wantOK)
+		}
+	}
+}
+
+func TestValidateEstimate(t *testing.T) {
+	project := &Project{PointScale: "0,1,2,3"}
+	estimatable := &Project{PointScale: "0,1,2,3", BugsAndChoresAreEstimatable: true}
+
+	tests := []struct {
+		name           string
+		project        *Project
+		storyType      StoryType
+		estimate       *float64
+		wantErr        bool
+		wantSuggestion *float64
+	}{
+		{"nil estimate", project, StoryTypeRelease, nil, false, nil},
+		{"feature in scale", project, StoryTypeFeature, Float64(2), false, nil},
+		{"feature not in scale", project, StoryTypeFeature, Float64(5), true, Float64(3)},
+		{"release", estimatable, StoryTypeRelease, Float64(1), true, nil},
+		{"bug not estimatable", project, StoryTypeBug, Float64(1), true, nil},
+		{"chore estimatable", estimatable, StoryTypeChore, Float64(1), false, nil},
+		{"invalid scale", &Project{PointScale: "x"}, StoryTypeFeature, Float64(1), true, nil},
+	}
+
+	for _, test := range tests {
+		err := ValidateEstimate(test.project, test.storyType, test.estimate)
+		if (err != nil) != test.wantErr {
+			t.Errorf("%v: ValidateEstimate() error = %v, wantErr %v", test.name, err, test.wantErr)
+			continue
+		}
+		if invalid, ok := err.(*ErrInvalidEstimate); ok || test.wantSuggestion != nil {
+			if !ok {
+				t.Errorf("%v: expected an *ErrInvalidEstimate, got %v", test.name, err)
+				continue
+			}
+			if !reflect.DeepEqual(invalid.Suggestion, test.wantSuggestion) {
+				t.Errorf("%v: Suggestion = %v, want %v", test.name, invalid.Suggestion, test.wantSuggestion)
+			}
+		}
+	}
+}
+
+func TestStoryServiceUpdateEstimateOfRelease(t *testing.T) {
+	project := &Project{ID: 1, PointScale: "0,1,2,3", BugsAndChoresAreEstimatable: true}
+	story := &Story{ID: 2, Type: StoryTypeRelease}
+
+	var updates int32
+	client := newTestClient(t, testRoutes(t, map[string]http.HandlerFunc{
+		"GET /projects/1":           respondJSON(project),
+		"GET /projects/1/stories/2": respondJSON(story),
+		"PUT /projects/1/stories/2": func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&updates, 1)
+			respondJSON(story)(w, r)
+		},
+	}))
+
+	_, _, err := client.Stories.Update(1, 2, &StoryRequest{Estimate: Float64(1)})
+	if _, ok := err.(*ErrInvalidEstimate); !ok {
+		t.Errorf("expected an *ErrInvalidEstimate, got %v", err)
+	}
+	if atomic.LoadInt32(&updates) != 0 {
+		t.Errorf("the story was updated")
+	}
+}
+
+func TestStoryServiceUpdateEstimateStaleScale(t *testing.T) {
+	project := &Project{ID: 1, PointScale: "0,1,2,3"}
+	story := &Story{ID: 2, Type: StoryTypeFeature}
+
+	var updates int32
+	client := newTestClient(t, testRoutes(t, map[string]http.HandlerFunc{
+		"GET /projects/1":           respondJSON(project),
+		"GET /projects/1/stories/2": respondJSON(story),
+		"PUT /projects/1/stories/2": func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&updates, 1)
+			respondJSON(story)(w, r)
+		},
+	}))
+
+	if _, _, err := client.Stories.Update(1, 2, &StoryRequest{Estimate: Float64(2)}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The point scale is changed in Tracker while the project is cached.
+	project.PointScale = "0,1,2,3,5,8"
+	if _, _, err := client.Stories.Update(1, 2, &StoryRequest{Estimate: Float64(5)}); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&updates); n != 2 {
+		t.Errorf("updates = %v, want 2", n)
+	}
+
+	if _, _, err := client.Stories.Update(1, 2, &StoryRequest{Estimate: Float64(4)}); err == nil {
+		t.Error("expected an error for an estimate not in the point scale")
+	}
+}
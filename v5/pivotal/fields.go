@@ -0,0 +1,61 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"net/url"
+	"strings"
+)
+
+// FieldsDefault selects the fields returned by the API by default.
+const FieldsDefault = ":default"
+
+// FieldSelector builds the value of the fields query parameter
+// that controls which fields of a resource are returned by the API.
+//
+// For example
+//
+//	Fields(FieldsDefault, "tasks").Nested("owners", Fields("name", "email"))
+//
+// selects the default fields, the tasks and the name and email of the owners,
+// which is ":default,tasks,owners(name,email)".
+type FieldSelector struct {
+	fields []string
+}
+
+// Fields returns a FieldSelector selecting the given fields.
+func Fields(names ...string) *FieldSelector {
+	return &FieldSelector{fields: append([]string(nil), names...)}
+}
+
+// Add selects the given fields in addition to the fields already selected.
+func (selector *FieldSelector) Add(names ...string) *FieldSelector {
+	selector.fields = append(selector.fields, names...)
+	return selector
+}
+
+// Nested selects the given fields of the nested resource called name.
+func (selector *FieldSelector) Nested(name string, nested *FieldSelector) *FieldSelector {
+	if nested == nil || len(nested.fields) == 0 {
+		return selector.Add(name)
+	}
+	return selector.Add(name + "(" + nested.String() + ")")
+}
+
+// String returns the fields query parameter value.
+func (selector *FieldSelector) String() string {
+	if selector == nil {
+		return ""
+	}
+	return strings.Join(selector.fields, ",")
+}
+
+// withFields appends the fields query parameter to u in case fields are selected.
+func withFields(u string, fields *FieldSelector) string {
+	if s := fields.String(); s != "" {
+		u += "?" + url.Values{"fields": {s}}.Encode()
+	}
+	return u
+}
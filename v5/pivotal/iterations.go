@@ -11,6 +11,17 @@ import (
 	"time"
 )
 
+const (
+	// IterationScopeDone wraps the iteration scope enum in the variable name.
+	IterationScopeDone = "done"
+	// IterationScopeCurrent wraps the iteration scope enum in the variable name.
+	IterationScopeCurrent = "current"
+	// IterationScopeBacklog wraps the iteration scope enum in the variable name.
+	IterationScopeBacklog = "backlog"
+	// IterationScopeCurrentBacklog wraps the iteration scope enum in the variable name.
+	IterationScopeCurrentBacklog = "current_backlog"
+)
+
 // Iteration is the primary data object for the IterationService.
 type Iteration struct {
 	Number          int        `json:"number,omitempty"`
@@ -37,9 +48,39 @@ func newIterationService(client *Client) *IterationService {
 	return &IterationService{client}
 }
 
+// List returns the iterations of the project within the given scope,
+// see the IterationScope* constants. All iterations are returned when scope is empty.
+// The stories of the iterations are returned in priority order.
+func (service *IterationService) List(projectID int, scope string) ([]*Iteration, error) {
+	reqFunc := func() *http.Request {
+		u := fmt.Sprintf("projects/%v/iterations", projectID)
+		if scope != "" {
+			u += "?scope=" + scope
+		}
+		req, _ := service.client.NewRequest("GET", u, nil)
+		return req
+	}
+	cursor, err := newCursor(service.client, reqFunc, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var iterations []*Iteration
+	if err := cursor.all(&iterations); err != nil {
+		return nil, err
+	}
+	return iterations, nil
+}
+
 // Get return an iteration from the project.
 func (service *IterationService) Get(projectID int, iterationNumber int) (*Iteration, *http.Response, error) {
-	u := fmt.Sprintf("projects/%v/iterations/%v", projectID, iterationNumber)
+	return service.GetWithFields(projectID, iterationNumber, nil)
+}
+
+// GetWithFields is the same as Get, but the iteration returned contains
+// only the fields selected, including nested resources such as stories.
+func (service *IterationService) GetWithFields(projectID int, iterationNumber int, fields *FieldSelector) (*Iteration, *http.Response, error) {
+	u := withFields(fmt.Sprintf("projects/%v/iterations/%v", projectID, iterationNumber), fields)
 	req, err := service.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
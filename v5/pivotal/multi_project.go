@@ -0,0 +1,114 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrNoProjects is returned by StoryService.ListAcrossProjects in case
+// no projects are given and the authenticated user has no projects either.
+var ErrNoProjects = errors.New("no projects to query")
+
+// DefaultMultiProjectConcurrency is the number of projects queried in parallel
+// by StoryService.ListAcrossProjects in case MultiProjectQuery.Concurrency is not set.
+const DefaultMultiProjectConcurrency = 4
+
+// MultiProjectQuery describes a story query to be run in multiple projects.
+type MultiProjectQuery struct {
+	// ProjectIDs lists the projects to query. In case it is empty,
+	// all the projects of the authenticated user are queried.
+	ProjectIDs []int
+
+	// Filter is the story filter, see StoryService.List.
+	Filter string
+
+	// Fields optionally selects the story fields, see StoryService.ListWithFields.
+	Fields *FieldSelector
+
+	// Concurrency is the maximum number of projects queried in parallel,
+	// DefaultMultiProjectConcurrency is used when not set.
+	Concurrency int
+
+	// Less optionally sorts the merged stories.
+	Less func(a, b *Story) bool
+}
+
+// MultiProjectStories is the result of a multi-project story query.
+type MultiProjectStories struct {
+	// Stories contains the stories of all the projects queried successfully.
+	// The stories are sorted using MultiProjectQuery.Less, or grouped
+	// by project in the order of the project IDs queried otherwise.
+	Stories []*Story
+
+	// ByProject maps the project IDs queried successfully to their stories.
+	ByProject map[int][]*Story
+}
+
+// ListAcrossProjects runs the same story query in multiple projects
+// concurrently and merges the results.
+//
+// In case query is nil, all the stories of all the projects of the authenticated
+// user are listed. ErrNoProjects is returned in case there is no project to query.
+//
+// In case some of the projects fail, the stories of the other projects are
+// still returned, together with an *ErrMultiProject listing the failures.
+func (service *StoryService) ListAcrossProjects(query *MultiProjectQuery) (*MultiProjectStories, error) {
+	if query == nil {
+		query = &MultiProjectQuery{}
+	}
+
+	projectIDs := query.ProjectIDs
+	if len(projectIDs) == 0 {
+		me, _, err := service.client.Me.Get()
+		if err != nil {
+			return nil, err
+		}
+		if me.ProjectIDs != nil {
+			projectIDs = *me.ProjectIDs
+		}
+		if len(projectIDs) == 0 {
+			return nil, ErrNoProjects
+		}
+	}
+
+	concurrency := query.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultMultiProjectConcurrency
+	}
+
+	var (
+		stories = make([][]*Story, len(projectIDs))
+		errs    = make([]error, len(projectIDs))
+	)
+	parallel(len(projectIDs), concurrency, func(i int) {
+		stories[i], errs[i] = service.ListWithFields(projectIDs[i], query.Filter, query.Fields)
+	})
+
+	var (
+		result   = MultiProjectStories{ByProject: make(map[int][]*Story, len(projectIDs))}
+		multiErr ErrMultiProject
+	)
+	for i, projectID := range projectIDs {
+		if errs[i] != nil {
+			multiErr.Errors = append(multiErr.Errors, &ErrProject{projectID, errs[i]})
+			continue
+		}
+		result.ByProject[projectID] = stories[i]
+		result.Stories = append(result.Stories, stories[i]...)
+	}
+
+	if query.Less != nil {
+		sort.SliceStable(result.Stories, func(i, j int) bool {
+			return query.Less(result.Stories[i], result.Stories[j])
+		})
+	}
+
+	if len(multiErr.Errors) != 0 {
+		return &result, &multiErr
+	}
+	return &result, nil
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestStoryServiceListAcrossProjects(t *testing.T) {
+	client := newTestClient(t, testRoutes(t, map[string]http.HandlerFunc{
+		"GET /me":                 respondJSON(&Me{ID: 1, ProjectIDs: &[]int{1, 2}}),
+		"GET /projects/1/stories": respondJSON([]*Story{{ID: 10, ProjectID: 1}}),
+		"GET /projects/2/stories": respondJSON([]*Story{{ID: 20, ProjectID: 2}, {ID: 21, ProjectID: 2}}),
+	}))
+
+	result, err := client.Stories.ListAcrossProjects(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Stories) != 3 || len(result.ByProject[1]) != 1 || len(result.ByProject[2]) != 2 {
+		t.Errorf("unexpected result: %v stories, by project %v", len(result.Stories), result.ByProject)
+	}
+}
+
+func TestStoryServiceListAcrossProjectsNoProjects(t *testing.T) {
+	for _, me := range []*Me{{ID: 1}, {ID: 1, ProjectIDs: &[]int{}}} {
+		client := newTestClient(t, testRoutes(t, map[string]http.HandlerFunc{
+			"GET /me": respondJSON(me),
+		}))
+
+		if _, err := client.Stories.ListAcrossProjects(&MultiProjectQuery{Filter: "label:api"}); err != ErrNoProjects {
+			t.Errorf("expected ErrNoProjects, got %v", err)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	// NotificationTypeMention wraps the notification type enum in the variable name.
+	NotificationTypeMention = "mention"
+	// NotificationTypeComment wraps the notification type enum in the variable name.
+	NotificationTypeComment = "comment"
+	// NotificationTypeStoryOwner wraps the notification type enum in the variable name.
+	NotificationTypeStoryOwner = "owner"
+	// NotificationTypeStoryRequester wraps the notification type enum in the variable name.
+	NotificationTypeStoryRequester = "requester"
+	// NotificationTypeStoryState wraps the notification type enum in the variable name.
+	NotificationTypeStoryState = "story_state"
+)
+
+// Notification is the primary data object for the NotificationService.
+//
+// The Project, Story and Epic fields are references only,
+// containing just the ID and the name of the resource.
+type Notification struct {
+	ID               int        `json:"id,omitempty"`
+	NotificationType string     `json:"notification_type,omitempty"`
+	Action           string     `json:"action,omitempty"`
+	Message          string     `json:"message,omitempty"`
+	Context          string     `json:"context,omitempty"`
+	Project          *Project   `json:"project,omitempty"`
+	Performer        *Person    `json:"performer,omitempty"`
+	Story            *Story     `json:"story,omitempty"`
+	Epic             *Epic      `json:"epic,omitempty"`
+	CommentID        int        `json:"comment_id,omitempty"`
+	ReadAt           *time.Time `json:"read_at,omitempty"`
+	CreatedAt        *time.Time `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+	Kind             string     `json:"kind,omitempty"`
+}
+
+// NotificationService wraps the client context for interacting with
+// the notifications of the authenticated user.
+type NotificationService struct {
+	client *Client
+}
+
+func newNotificationService(client *Client) *NotificationService {
+	return &NotificationService{client}
+}
+
+// List returns the notifications of the authenticated user, newest first.
+func (service *NotificationService) List() ([]*Notification, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "my/notifications", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var notifications []*Notification
+	resp, err := service.client.Do(req, &notifications)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return notifications, resp, nil
+}
+
+// ListUnread returns the notifications of the authenticated user
+// that are not marked as read yet.
+func (service *NotificationService) ListUnread() ([]*Notification, *http.Response, error) {
+	notifications, resp, err := service.List()
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var unread []*Notification
+	for _, notification := range notifications {
+		if notification.ReadAt == nil {
+			unread = append(unread, notification)
+		}
+	}
+	return unread, resp, nil
+}
+
+// MarkRead marks a single notification as read.
+func (service *NotificationService) MarkRead(notificationID int) (*Notification, *http.Response, error) {
+	u := fmt.Sprintf("my/notifications/%v", notificationID)
+	req, err := service.client.NewRequest("PUT", u, struct {
+		ReadAt time.Time `json:"read_at"`
+	}{time.Now()})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var notification Notification
+	resp, err := service.client.Do(req, &notification)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &notification, resp, nil
+}
+
+// MarkReadBefore marks the given notification and all the older ones as read.
+func (service *NotificationService) MarkReadBefore(notificationID int) (*http.Response, error) {
+	req, err := service.client.NewRequest("PUT", "my/notifications/mark_read", struct {
+		Before int `json:"before"`
+	}{notificationID})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.client.Do(req, nil)
+}
+
+// MarkAllRead marks all the notifications of the authenticated user as read.
+func (service *NotificationService) MarkAllRead() (*http.Response, error) {
+	notifications, resp, err := service.List()
+	if err != nil {
+		return resp, err
+	}
+
+	latestID := 0
+	for _, notification := range notifications {
+		if notification.ID > latestID {
+			latestID = notification.ID
+		}
+	}
+	if latestID == 0 {
+		return resp, nil
+	}
+
+	return service.MarkReadBefore(latestID)
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrPersonNotFound is returned by PeopleDirectory when no project member matches.
+var ErrPersonNotFound = errors.New("person not found")
+
+// PeopleDirectory resolves the people referenced by stories, e.g. using OwnerIDs
+// or RequestedByID, to the members of a project.
+//
+// The directory is loaded from MembershipService.List on first use and cached.
+// The cache is reloaded once it is older than the TTL, or after it is invalidated
+// explicitly or by a membership activity passed to HandleActivity.
+// PeopleDirectory is safe for concurrent use.
+type PeopleDirectory struct {
+	client    *Client
+	projectID int
+	ttl       time.Duration
+
+	mu         sync.Mutex
+	loadedAt   time.Time
+	byID       map[int]*Person
+	byEmail    map[string]*Person
+	byUsername map[string]*Person
+	byInitials map[string]*Person
+}
+
+// Directory returns a PeopleDirectory for the given project.
+// In case ttl is zero, the directory is only reloaded when invalidated.
+func (service *MembershipService) Directory(projectID int, ttl time.Duration) *PeopleDirectory {
+	return &PeopleDirectory{
+		client:    service.client,
+		projectID: projectID,
+		ttl:       ttl,
+	}
+}
+
+// Person returns the project member with the given person ID.
+func (d *PeopleDirectory) Person(personID int) (*Person, error) {
+	return d.lookup(func() *Person { return d.byID[personID] })
+}
+
+// People resolves the given person IDs, e.g. Story.OwnerIDs, keeping the order.
+// In case any of the IDs is not a project member, ErrPersonNotFound is returned.
+func (d *PeopleDirectory) People(personIDs []int) ([]*Person, error) {
+	people := make([]*Person, 0, len(personIDs))
+	for _, id := range personIDs {
+		person, err := d.Person(id)
+		if err != nil {
+			return nil, err
+		}
+		people = append(people, person)
+	}
+	return people, nil
+}
+
+// ByEmail returns the project member with the given email, ignoring case.
+func (d *PeopleDirectory) ByEmail(email string) (*Person, error) {
+	return d.lookup(func() *Person { return d.byEmail[strings.ToLower(email)] })
+}
+
+// ByUsername returns the project member with the given username, ignoring case.
+func (d *PeopleDirectory) ByUsername(username string) (*Person, error) {
+	return d.lookup(func() *Person { return d.byUsername[strings.ToLower(username)] })
+}
+
+// ByInitials returns the project member with the given initials, ignoring case.
+func (d *PeopleDirectory) ByInitials(initials string) (*Person, error) {
+	return d.lookup(func() *Person { return d.byInitials[strings.ToLower(initials)] })
+}
+
+// Invalidate drops the cached members so that they are reloaded on next use.
+func (d *PeopleDirectory) Invalidate() {
+	d.mu.Lock()
+	d.byID = nil
+	d.mu.Unlock()
+}
+
+// HandleActivity invalidates the directory in case the activity
+// changed the memberships of the project.
+func (d *PeopleDirectory) HandleActivity(activity *Activity) {
+	if activity.Project.ID != 0 && activity.Project.ID != d.projectID {
+		return
+	}
+	for _, change := range activity.Changes {
+		if change.Kind == "project_membership" {
+			d.Invalidate()
+			return
+		}
+	}
+}
+
+func (d *PeopleDirectory) lookup(find func() *Person) (*Person, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	expired := d.ttl != 0 && time.Since(d.loadedAt) > d.ttl
+	if d.byID == nil || expired {
+		if err := d.load(); err != nil {
+			return nil, err
+		}
+	}
+
+	person := find()
+	if person == nil {
+		return nil, ErrPersonNotFound
+	}
+	return person, nil
+}
+
+func (d *PeopleDirectory) load() error {
+	memberships, _, err := d.client.Memberships.List(d.projectID)
+	if err != nil {
+		return err
+	}
+
+	d.byID = make(map[int]*Person, len(memberships))
+	d.byEmail = make(map[string]*Person, len(memberships))
+	d.byUsername = make(map[string]*Person, len(memberships))
+	d.byInitials = make(map[string]*Person, len(memberships))
+	for _, membership := range memberships {
+		person := membership.Person
+		d.byID[person.ID] = &person
+		if person.Email != "" {
+			d.byEmail[strings.ToLower(person.Email)] = &person
+		}
+		if person.Username != "" {
+			d.byUsername[strings.ToLower(person.Username)] = &person
+		}
+		if person.Initials != "" {
+			d.byInitials[strings.ToLower(person.Initials)] = &person
+		}
+	}
+	d.loadedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import "time"
+
+// String returns a pointer to the given string value.
+func String(v string) *string { return &v }
+
+// Int returns a pointer to the given int value.
+func Int(v int) *int { return &v }
+
+// Float64 returns a pointer to the given float64 value.
+func Float64(v float64) *float64 { return &v }
+
+// Bool returns a pointer to the given bool value.
+func Bool(v bool) *bool { return &v }
+
+// Time returns a pointer to the given time.Time value.
+func Time(v time.Time) *time.Time { return &v }
+
+// Ints returns a pointer to the given []int value.
+// A nil slice is turned into an empty one so that it is sent as [].
+func Ints(v ...int) *[]int {
+	if v == nil {
+		v = []int{}
+	}
+	return &v
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"time"
+)
+
+// StoryBreakdown summarizes a set of stories by state and points.
+// Release markers are not counted as stories.
+type StoryBreakdown struct {
+	// StoryCount is the number of stories.
+	StoryCount int
+
+	// CountsByState maps the StoryState* states to the number of stories.
+	CountsByState map[StoryState]int
+
+	// PointsByState maps the StoryState* states to the points estimated.
+	PointsByState map[StoryState]float64
+
+	// TotalPoints is the sum of all the estimates.
+	TotalPoints float64
+
+	// AcceptedPoints is the sum of the estimates of the accepted stories.
+	AcceptedPoints float64
+
+	// RemainingPoints is the sum of the estimates of the stories not accepted yet.
+	RemainingPoints float64
+
+	// UnestimatedCount is the number of stories without an estimate.
+	UnestimatedCount int
+
+	// BlockedCount is the number of stories with an unresolved blocker.
+	// Blockers are only taken into account when Story.Blockers is set.
+	BlockedCount int
+}
+
+// NewStoryBreakdown computes the StoryBreakdown of the given stories.
+func NewStoryBreakdown(stories []*Story) *StoryBreakdown {
+	breakdown := &StoryBreakdown{
+		CountsByState: make(map[StoryState]int),
+		PointsByState: make(map[StoryState]float64),
+	}
+	for _, story := range stories {
+		if story.Type == StoryTypeRelease {
+			continue
+		}
+
+		breakdown.StoryCount++
+		breakdown.CountsByState[story.State]++
+
+		if story.Estimate == nil {
+			breakdown.UnestimatedCount++
+		} else {
+			points := *story.Estimate
+			breakdown.PointsByState[story.State] += points
+			breakdown.TotalPoints += points
+			if story.State == StoryStateAccepted {
+				breakdown.AcceptedPoints += points
+			} else {
+				breakdown.RemainingPoints += points
+			}
+		}
+
+		for _, blocker := range story.Blockers {
+			if !blocker.Resolved {
+				breakdown.BlockedCount++
+				break
+			}
+		}
+	}
+	return breakdown
+}
+
+// PercentComplete returns the share of accepted points in percent. In case
+// none of the stories is estimated, the share of accepted stories is used.
+func (breakdown *StoryBreakdown) PercentComplete() float64 {
+	if breakdown.TotalPoints > 0 {
+		return 100 * breakdown.AcceptedPoints / breakdown.TotalPoints
+	}
+	if breakdown.StoryCount > 0 {
+		return 100 * float64(breakdown.CountsByState[StoryStateAccepted]) / float64(breakdown.StoryCount)
+	}
+	return 0
+}
+
+// EpicProgress is the rollup of the stories belonging to an epic.
+type EpicProgress struct {
+	*StoryBreakdown
+
+	// Epic is the epic the progress is computed for.
+	Epic *Epic
+
+	// Velocity is the velocity of the current iteration.
+	Velocity float64
+
+	// EstimatedCompletionIteration is the number of the iteration holding
+	// the last story of the epic that is not accepted yet, as projected
+	// by Pivotal Tracker for the current iteration and the backlog. The stories
+	// ahead of the epic stories in the backlog are taken into account.
+	// In case all the scheduled stories of the epic are accepted,
+	// it is the number of the current iteration.
+	EstimatedCompletionIteration int
+
+	// EstimatedCompletionFinish is the finish of the iteration
+	// the epic is projected to be completed in.
+	EstimatedCompletionFinish *time.Time
+
+	// Unscheduled lists the stories of the epic in the icebox. Tracker does not
+	// schedule them, so they are not part of the projected completion.
+	Unscheduled []*Story
+}
+
+// Progress computes the EpicProgress of an epic.
+//
+// Progress sends at least 3 HTTP requests - one to get the epic label, another
+// to get the epic stories including their blockers and the last ones to get
+// the current iteration and the backlog as projected by Pivotal Tracker.
+func (service *EpicService) Progress(projectID, epicID int) (*EpicProgress, error) {
+	epic, _, err := service.GetWithFields(projectID, epicID, Fields(FieldsDefault, "label"))
+	if err != nil {
+		return nil, err
+	}
+	if epic.Label == nil || epic.Label.Name == "" {
+		return nil, &ErrFieldNotSet{"label"}
+	}
+
+	stories, err := service.client.Stories.ListWithFields(
+		projectID, epicLabelFilter(epic.Label.Name), Fields(FieldsDefault, "blockers"))
+	if err != nil {
+		return nil, err
+	}
+
+	iterations, err := service.client.Iterations.List(projectID, IterationScopeCurrentBacklog)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &EpicProgress{
+		StoryBreakdown: NewStoryBreakdown(stories),
+		Epic:           epic,
+	}
+
+	remaining := make(map[int]bool, len(stories))
+	for _, story := range stories {
+		switch {
+		case story.Type == StoryTypeRelease || story.State == StoryStateAccepted:
+		case story.State == StoryStateUnscheduled:
+			progress.Unscheduled = append(progress.Unscheduled, story)
+		default:
+			remaining[story.ID] = true
+		}
+	}
+
+	if len(iterations) != 0 {
+		current := iterations[0]
+		progress.Velocity = current.Velocity
+		progress.EstimatedCompletionIteration = current.Number
+		progress.EstimatedCompletionFinish = current.Finish
+	}
+	for _, iteration := range iterations {
+		for _, story := range iteration.Stories {
+			if remaining[story.ID] {
+				progress.EstimatedCompletionIteration = iteration.Number
+				progress.EstimatedCompletionFinish = iteration.Finish
+			}
+		}
+	}
+	return progress, nil
+}
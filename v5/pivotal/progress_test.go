@@ -0,0 +1,73 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestEpicServiceProgress(t *testing.T) {
+	iterations := []*Iteration{
+		{Number: 3, Velocity: 5, Stories: []*Story{{ID: 10}, {ID: 11}, {ID: 20}}},
+		{Number: 4, Stories: []*Story{{ID: 21}, {ID: 22}}},
+		{Number: 5, Stories: []*Story{{ID: 23}, {ID: 12}, {ID: 24}}},
+		{Number: 6, Stories: []*Story{{ID: 25}}},
+	}
+
+	tests := []struct {
+		name        string
+		stories     []*Story
+		want        int
+		unscheduled int
+	}{
+		{
+			name: "last remaining story deep in the backlog",
+			stories: []*Story{
+				{ID: 10, State: StoryStateAccepted},
+				{ID: 11, State: StoryStateStarted},
+				{ID: 12, State: StoryStateUnstarted},
+				{ID: 13, State: StoryStateUnscheduled},
+				{ID: 14, Type: StoryTypeRelease, State: StoryStateUnstarted},
+			},
+			want:        5,
+			unscheduled: 1,
+		},
+		{
+			name: "all scheduled stories accepted",
+			stories: []*Story{
+				{ID: 10, State: StoryStateAccepted},
+				{ID: 13, State: StoryStateUnscheduled},
+			},
+			want:        3,
+			unscheduled: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newTestClient(t, testRoutes(t, map[string]http.HandlerFunc{
+				"GET /projects/1/epics/2":    respondJSON(&Epic{ID: 2, Label: &Label{Name: "api"}}),
+				"GET /projects/1/stories":    respondJSON(test.stories),
+				"GET /projects/1/iterations": respondJSON(iterations),
+			}))
+
+			progress, err := client.Epic.Progress(1, 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if progress.EstimatedCompletionIteration != test.want {
+				t.Errorf("EstimatedCompletionIteration = %v, want %v",
+					progress.EstimatedCompletionIteration, test.want)
+			}
+			if len(progress.Unscheduled) != test.unscheduled {
+				t.Errorf("%v unscheduled stories, want %v", len(progress.Unscheduled), test.unscheduled)
+			}
+			if progress.Velocity != 5 {
+				t.Errorf("Velocity = %v, want 5", progress.Velocity)
+			}
+		})
+	}
+}
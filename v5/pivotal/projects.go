@@ -8,6 +8,7 @@ package pivotal
 import (
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 )
 
@@ -98,15 +99,39 @@ type Project struct {
 // specific details.
 type ProjectService struct {
 	client *Client
+
+	// Projects cached for validating story requests, see Invalidate.
+	cacheMu  sync.Mutex
+	cache    map[int]*cachedProject
+	cacheTTL time.Duration
+}
+
+type cachedProject struct {
+	project  *Project
+	loadedAt time.Time
 }
 
+// DefaultProjectCacheTTL is how long the project settings used to validate
+// story requests are cached, see ProjectService.SetCacheTTL.
+const DefaultProjectCacheTTL = 5 * time.Minute
+
 func newProjectService(client *Client) *ProjectService {
-	return &ProjectService{client}
+	return &ProjectService{
+		client:   client,
+		cache:    make(map[int]*cachedProject),
+		cacheTTL: DefaultProjectCacheTTL,
+	}
 }
 
 // List returns all active projects for the current user.
 func (service *ProjectService) List() ([]*Project, *http.Response, error) {
-	req, err := service.client.NewRequest("GET", "projects", nil)
+	return service.ListWithFields(nil)
+}
+
+// ListWithFields is the same as List, but the projects returned contain
+// only the fields selected, including nested resources.
+func (service *ProjectService) ListWithFields(fields *FieldSelector) ([]*Project, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", withFields("projects", fields), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -122,7 +147,13 @@ func (service *ProjectService) List() ([]*Project, *http.Response, error) {
 
 // Get returns a specific project's information.
 func (service *ProjectService) Get(projectID int) (*Project, *http.Response, error) {
-	u := fmt.Sprintf("projects/%v", projectID)
+	return service.GetWithFields(projectID, nil)
+}
+
+// GetWithFields is the same as Get, but the project returned contains
+// only the fields selected, including nested resources.
+func (service *ProjectService) GetWithFields(projectID int, fields *FieldSelector) (*Project, *http.Response, error) {
+	u := withFields(fmt.Sprintf("projects/%v", projectID), fields)
 	req, err := service.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -136,3 +167,44 @@ func (service *ProjectService) Get(projectID int) (*Project, *http.Response, err
 
 	return &project, resp, err
 }
+
+// getCached returns the project from the cache, fetching it in case it is
+// not cached yet or the cached entry is older than the cache TTL.
+// The second value reports whether the project was taken from the cache.
+func (service *ProjectService) getCached(projectID int) (*Project, bool, error) {
+	service.cacheMu.Lock()
+	entry, ok := service.cache[projectID]
+	ttl := service.cacheTTL
+	service.cacheMu.Unlock()
+	if ok && (ttl == 0 || time.Since(entry.loadedAt) <= ttl) {
+		return entry.project, true, nil
+	}
+
+	project, _, err := service.Get(projectID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	service.cacheMu.Lock()
+	service.cache[projectID] = &cachedProject{project, time.Now()}
+	service.cacheMu.Unlock()
+	return project, false, nil
+}
+
+// SetCacheTTL sets how long the project settings are cached,
+// DefaultProjectCacheTTL is used by default. In case ttl is zero,
+// the settings are only reloaded when invalidated.
+func (service *ProjectService) SetCacheTTL(ttl time.Duration) {
+	service.cacheMu.Lock()
+	service.cacheTTL = ttl
+	service.cacheMu.Unlock()
+}
+
+// Invalidate drops the cached settings of a project. The settings,
+// e.g. the point scale, are cached to validate story estimates and
+// are reloaded once the cache TTL passes or they are invalidated.
+func (service *ProjectService) Invalidate(projectID int) {
+	service.cacheMu.Lock()
+	delete(service.cache, projectID)
+	service.cacheMu.Unlock()
+}
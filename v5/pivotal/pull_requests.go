@@ -0,0 +1,203 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	// PullRequestStatusOpen wraps the pull request status enum in the variable name.
+	PullRequestStatusOpen = "open"
+	// PullRequestStatusClosed wraps the pull request status enum in the variable name.
+	PullRequestStatusClosed = "closed"
+	// PullRequestStatusMerged wraps the pull request status enum in the variable name.
+	PullRequestStatusMerged = "merged"
+)
+
+// PullRequest is a pull request in a source hosting service linked to a Story.
+type PullRequest struct {
+	ID          int        `json:"id,omitempty"`
+	StoryID     int        `json:"story_id,omitempty"`
+	Owner       string     `json:"owner,omitempty"`
+	Repo        string     `json:"repo,omitempty"`
+	Number      int        `json:"number,omitempty"`
+	HostURL     string     `json:"host_url,omitempty"`
+	OriginalURL string     `json:"original_url,omitempty"`
+	Status      string     `json:"status,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	Kind        string     `json:"kind,omitempty"`
+}
+
+// PullRequestRequest is used to link a pull request to a Story.
+type PullRequestRequest struct {
+	Owner   string `json:"owner,omitempty"`
+	Repo    string `json:"repo,omitempty"`
+	Number  int    `json:"number,omitempty"`
+	HostURL string `json:"host_url,omitempty"`
+}
+
+// Branch is a branch in a source hosting service linked to a Story.
+type Branch struct {
+	ID        int        `json:"id,omitempty"`
+	StoryID   int        `json:"story_id,omitempty"`
+	Owner     string     `json:"owner,omitempty"`
+	Repo      string     `json:"repo,omitempty"`
+	Name      string     `json:"name,omitempty"`
+	HostURL   string     `json:"host_url,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	Kind      string     `json:"kind,omitempty"`
+}
+
+// BranchRequest is used to link a branch to a Story.
+type BranchRequest struct {
+	Owner   string `json:"owner,omitempty"`
+	Repo    string `json:"repo,omitempty"`
+	Name    string `json:"name,omitempty"`
+	HostURL string `json:"host_url,omitempty"`
+}
+
+// HasOpenPullRequest returns true in case any of the pull requests
+// embedded in the story is still open.
+func (story *Story) HasOpenPullRequest() bool {
+	for _, pr := range story.PullRequests {
+		if pr.Status == PullRequestStatusOpen {
+			return true
+		}
+	}
+	return false
+}
+
+// ListPullRequests returns the pull requests linked to a Story.
+func (service *StoryService) ListPullRequests(projectID, storyID int) ([]*PullRequest, *http.Response, error) {
+	u := fmt.Sprintf("projects/%v/stories/%v/pull_requests", projectID, storyID)
+	req, err := service.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pullRequests []*PullRequest
+	resp, err := service.client.Do(req, &pullRequests)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pullRequests, resp, nil
+}
+
+// AddPullRequest will link a pull request to a Story.
+func (service *StoryService) AddPullRequest(projectID, storyID int, pullRequest *PullRequestRequest) (*PullRequest, *http.Response, error) {
+	switch {
+	case pullRequest.Owner == "":
+		return nil, nil, &ErrFieldNotSet{"owner"}
+	case pullRequest.Repo == "":
+		return nil, nil, &ErrFieldNotSet{"repo"}
+	case pullRequest.Number == 0:
+		return nil, nil, &ErrFieldNotSet{"number"}
+	}
+
+	u := fmt.Sprintf("projects/%v/stories/%v/pull_requests", projectID, storyID)
+	req, err := service.client.NewRequest("POST", u, pullRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var newPullRequest PullRequest
+	resp, err := service.client.Do(req, &newPullRequest)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &newPullRequest, resp, nil
+}
+
+// RemovePullRequest will unlink a pull request from a Story.
+func (service *StoryService) RemovePullRequest(projectID, storyID, pullRequestID int) (*http.Response, error) {
+	u := fmt.Sprintf("projects/%v/stories/%v/pull_requests/%v", projectID, storyID, pullRequestID)
+	req, err := service.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.client.Do(req, nil)
+}
+
+// ListBranches returns the branches linked to a Story.
+func (service *StoryService) ListBranches(projectID, storyID int) ([]*Branch, *http.Response, error) {
+	u := fmt.Sprintf("projects/%v/stories/%v/branches", projectID, storyID)
+	req, err := service.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var branches []*Branch
+	resp, err := service.client.Do(req, &branches)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return branches, resp, nil
+}
+
+// AddBranch will link a branch to a Story.
+func (service *StoryService) AddBranch(projectID, storyID int, branch *BranchRequest) (*Branch, *http.Response, error) {
+	switch {
+	case branch.Owner == "":
+		return nil, nil, &ErrFieldNotSet{"owner"}
+	case branch.Repo == "":
+		return nil, nil, &ErrFieldNotSet{"repo"}
+	case branch.Name == "":
+		return nil, nil, &ErrFieldNotSet{"name"}
+	}
+
+	u := fmt.Sprintf("projects/%v/stories/%v/branches", projectID, storyID)
+	req, err := service.client.NewRequest("POST", u, branch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var newBranch Branch
+	resp, err := service.client.Do(req, &newBranch)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &newBranch, resp, nil
+}
+
+// RemoveBranch will unlink a branch from a Story.
+func (service *StoryService) RemoveBranch(projectID, storyID, branchID int) (*http.Response, error) {
+	u := fmt.Sprintf("projects/%v/stories/%v/branches/%v", projectID, storyID, branchID)
+	req, err := service.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.client.Do(req, nil)
+}
+
+// ListWithOpenPullRequests returns all stories matching the filter
+// that have at least one open pull request linked.
+//
+// The stories are fetched together with their pull requests and branches,
+// so the PullRequests and Branches fields of the returned stories are set.
+func (service *StoryService) ListWithOpenPullRequests(projectID int, filter string) ([]*Story, error) {
+	stories, err := service.ListWithFields(projectID, filter, Fields(FieldsDefault, "pull_requests", "branches"))
+	if err != nil {
+		return nil, err
+	}
+
+	var open []*Story
+	for _, story := range stories {
+		if story.HasOpenPullRequest() {
+			open = append(open, story)
+		}
+	}
+	return open, nil
+}
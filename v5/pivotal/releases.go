@@ -0,0 +1,102 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"time"
+)
+
+// Release is a release marker together with the stories leading to it.
+type Release struct {
+	*StoryBreakdown
+
+	// Marker is the release story.
+	Marker *Story
+
+	// Stories lists the stories between the previous release marker
+	// and this one in backlog order.
+	Stories []*Story
+
+	// Iteration is the number of the iteration the release is projected
+	// to be completed in by Pivotal Tracker.
+	Iteration int
+
+	// ProjectedFinish is the finish of the iteration the release
+	// is projected to be completed in.
+	ProjectedFinish *time.Time
+}
+
+// MissesDeadline returns true in case the release has a deadline
+// that is before the projected finish of the release.
+func (release *Release) MissesDeadline() bool {
+	return release.Marker.Deadline != nil &&
+		release.ProjectedFinish != nil &&
+		release.ProjectedFinish.After(*release.Marker.Deadline)
+}
+
+// ReleaseService wraps the client context for working with release markers.
+type ReleaseService struct {
+	client *Client
+}
+
+func newReleaseService(client *Client) *ReleaseService {
+	return &ReleaseService{client}
+}
+
+// ListMarkers returns all the release markers of a project.
+func (service *ReleaseService) ListMarkers(projectID int) ([]*Story, error) {
+	return service.client.Stories.List(projectID, "type:"+string(StoryTypeRelease))
+}
+
+// List returns the release markers in the current iteration and the backlog
+// in backlog order, each together with the stories leading to it.
+//
+// The projection is the one computed by Pivotal Tracker when planning
+// the stories into iterations using the project velocity.
+func (service *ReleaseService) List(projectID int) ([]*Release, error) {
+	iterations, err := service.client.Iterations.List(projectID, IterationScopeCurrentBacklog)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		releases []*Release
+		stories  []*Story
+	)
+	for _, iteration := range iterations {
+		for _, story := range iteration.Stories {
+			if story.Type != StoryTypeRelease {
+				stories = append(stories, story)
+				continue
+			}
+
+			releases = append(releases, &Release{
+				StoryBreakdown:  NewStoryBreakdown(stories),
+				Marker:          story,
+				Stories:         stories,
+				Iteration:       iteration.Number,
+				ProjectedFinish: iteration.Finish,
+			})
+			stories = nil
+		}
+	}
+	return releases, nil
+}
+
+// ListAtRisk returns the releases that are projected to miss their deadline.
+func (service *ReleaseService) ListAtRisk(projectID int) ([]*Release, error) {
+	releases, err := service.List(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var atRisk []*Release
+	for _, release := range releases {
+		if release.MissesDeadline() {
+			atRisk = append(atRisk, release)
+		}
+	}
+	return atRisk, nil
+}
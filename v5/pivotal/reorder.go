@@ -0,0 +1,262 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// ErrEmptyPanel is returned when a story is to be moved to the top or bottom
+// of an iteration or the icebox that contains no other stories.
+var ErrEmptyPanel = errors.New("no story to position the story against")
+
+// StoryPosition describes where a story is to be moved by StoryService.Move.
+// Use the Position* functions to create a StoryPosition.
+type StoryPosition struct {
+	beforeID  int
+	afterID   int
+	iteration int
+	icebox    bool
+	bottom    bool
+}
+
+// PositionBefore places the story right before the given story.
+func PositionBefore(storyID int) *StoryPosition {
+	return &StoryPosition{beforeID: storyID}
+}
+
+// PositionAfter places the story right after the given story.
+func PositionAfter(storyID int) *StoryPosition {
+	return &StoryPosition{afterID: storyID}
+}
+
+// PositionTopOfIteration places the story at the top of the given iteration.
+func PositionTopOfIteration(iterationNumber int) *StoryPosition {
+	return &StoryPosition{iteration: iterationNumber}
+}
+
+// PositionBottomOfIteration places the story at the bottom of the given iteration.
+func PositionBottomOfIteration(iterationNumber int) *StoryPosition {
+	return &StoryPosition{iteration: iterationNumber, bottom: true}
+}
+
+// PositionTopOfIcebox places the story at the top of the icebox.
+func PositionTopOfIcebox() *StoryPosition {
+	return &StoryPosition{icebox: true}
+}
+
+// PositionBottomOfIcebox places the story at the bottom of the icebox.
+func PositionBottomOfIcebox() *StoryPosition {
+	return &StoryPosition{icebox: true, bottom: true}
+}
+
+// Move changes the position of a story in the project.
+//
+// Moving a story to the top or bottom of an iteration or the icebox requires
+// an additional request to find the story to position the story against.
+// Moving a story into the icebox also sets its state to StoryStateUnscheduled.
+func (service *StoryService) Move(projectID, storyID int, to *StoryPosition) (*Story, *http.Response, error) {
+	if to == nil {
+		return nil, nil, &ErrFieldNotSet{"position"}
+	}
+
+	var (
+		storyIDs []int
+		request  StoryRequest
+	)
+	switch {
+	case to.beforeID != 0:
+		request.BeforeID = &to.beforeID
+	case to.afterID != 0:
+		request.AfterID = &to.afterID
+	case to.icebox:
+		ids, err := service.iceboxStoryIDs(projectID)
+		if err != nil {
+			return nil, nil, err
+		}
+		storyIDs = ids
+		state := StoryStateUnscheduled
+		request.State = &state
+	case to.iteration != 0:
+		iteration, resp, err := service.client.Iterations.Get(projectID, to.iteration)
+		if err != nil {
+			return nil, resp, err
+		}
+		storyIDs = iterationStoryIDs(iteration)
+	default:
+		return nil, nil, &ErrFieldNotSet{"position"}
+	}
+
+	if to.icebox || to.iteration != 0 {
+		var others []int
+		for _, id := range storyIDs {
+			if id != storyID {
+				others = append(others, id)
+			}
+		}
+		if len(others) == 0 {
+			return nil, nil, ErrEmptyPanel
+		}
+		if to.bottom {
+			request.AfterID = &others[len(others)-1]
+		} else {
+			request.BeforeID = &others[0]
+		}
+	}
+
+	return service.Update(projectID, storyID, &request)
+}
+
+func (service *StoryService) iceboxStoryIDs(projectID int) ([]int, error) {
+	reqFunc := func() *http.Request {
+		u := fmt.Sprintf("projects/%v/stories?with_state=%v", projectID, StoryStateUnscheduled)
+		req, _ := service.client.NewRequest("GET", u, nil)
+		return req
+	}
+	cursor, err := newCursor(service.client, reqFunc, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var stories []*Story
+	if err := cursor.all(&stories); err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(stories))
+	for _, story := range stories {
+		ids = append(ids, story.ID)
+	}
+	return ids, nil
+}
+
+// StoryMove is a single step of a reorder plan. Exactly one of BeforeID
+// and AfterID is set.
+type StoryMove struct {
+	StoryID  int
+	BeforeID int
+	AfterID  int
+}
+
+// PlanReorder computes the moves needed to turn the current order of stories
+// into the desired order using the minimum number of moves.
+//
+// Stories in current that are not in desired are ignored, stories in desired
+// that are not in current are treated as being out of place. The moves are
+// to be applied in the order returned.
+func PlanReorder(current, desired []int) []*StoryMove {
+	positions := make(map[int]int, len(current))
+	for i, id := range current {
+		positions[id] = i
+	}
+
+	// The stories forming the longest subsequence of desired that is already
+	// ordered in current stay in place, the rest of them is moved.
+	keep := longestOrderedSubsequence(desired, positions)
+
+	var moves []*StoryMove
+	lastKept := -1
+	for i := len(desired) - 1; i >= 0; i-- {
+		if keep[i] {
+			continue
+		}
+		if i+1 < len(desired) {
+			moves = append(moves, &StoryMove{StoryID: desired[i], BeforeID: desired[i+1]})
+			continue
+		}
+		if lastKept == -1 {
+			for k := i - 1; k >= 0; k-- {
+				if keep[k] {
+					lastKept = k
+					break
+				}
+			}
+		}
+		if lastKept != -1 {
+			moves = append(moves, &StoryMove{StoryID: desired[i], AfterID: desired[lastKept]})
+		}
+	}
+	return moves
+}
+
+// longestOrderedSubsequence marks the elements of ids forming the longest
+// subsequence with increasing positions. Elements without a position are never marked.
+func longestOrderedSubsequence(ids []int, positions map[int]int) []bool {
+	var (
+		tails   []int // tails[l] is the index into ids ending the best subsequence of length l+1
+		parents = make([]int, len(ids))
+	)
+	for i, id := range ids {
+		parents[i] = -1
+		pos, ok := positions[id]
+		if !ok {
+			continue
+		}
+		l := sort.Search(len(tails), func(j int) bool {
+			return positions[ids[tails[j]]] >= pos
+		})
+		if l > 0 {
+			parents[i] = tails[l-1]
+		}
+		if l == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[l] = i
+		}
+	}
+
+	keep := make([]bool, len(ids))
+	if len(tails) != 0 {
+		for i := tails[len(tails)-1]; i != -1; i = parents[i] {
+			keep[i] = true
+		}
+	}
+	return keep
+}
+
+// Reorder applies the moves computed by PlanReorder one by one.
+// The moves that were applied successfully are returned.
+func (service *StoryService) Reorder(projectID int, current, desired []int) ([]*StoryMove, error) {
+	moves := PlanReorder(current, desired)
+	for i, move := range moves {
+		request := StoryRequest{}
+		if move.BeforeID != 0 {
+			request.BeforeID = &move.BeforeID
+		} else {
+			request.AfterID = &move.AfterID
+		}
+		if _, _, err := service.Update(projectID, move.StoryID, &request); err != nil {
+			return moves[:i], &ErrStory{move.StoryID, err}
+		}
+	}
+	return moves, nil
+}
+
+// ReorderIteration reorders the stories of an iteration to match the desired order.
+func (service *StoryService) ReorderIteration(projectID, iterationNumber int, desired []int) ([]*StoryMove, error) {
+	iteration, _, err := service.client.Iterations.Get(projectID, iterationNumber)
+	if err != nil {
+		return nil, err
+	}
+	return service.Reorder(projectID, iterationStoryIDs(iteration), desired)
+}
+
+// iterationStoryIDs returns the IDs of the stories of an iteration in order.
+// The default iteration payload nests the stories, story_ids are only used
+// in case the stories are not part of the payload.
+func iterationStoryIDs(iteration *Iteration) []int {
+	if len(iteration.Stories) == 0 {
+		return iteration.StoryIDs
+	}
+
+	ids := make([]int, 0, len(iteration.Stories))
+	for _, story := range iteration.Stories {
+		ids = append(ids, story.ID)
+	}
+	return ids
+}
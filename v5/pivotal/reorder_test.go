@@ -0,0 +1,203 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// applyMoves simulates the moves on the story order the way Tracker does.
+func applyMoves(t *testing.T, order []int, moves []*StoryMove) []int {
+	order = append([]int(nil), order...)
+	for _, move := range moves {
+		for i, id := range order {
+			if id == move.StoryID {
+				order = append(order[:i], order[i+1:]...)
+				break
+			}
+		}
+
+		target, offset := move.BeforeID, 0
+		if target == 0 {
+			target, offset = move.AfterID, 1
+		}
+		index := -1
+		for i, id := range order {
+			if id == target {
+				index = i + offset
+				break
+			}
+		}
+		if index == -1 {
+			t.Fatalf("move %+v positions the story against a missing story", move)
+		}
+		order = append(order[:index], append([]int{move.StoryID}, order[index:]...)...)
+	}
+	return order
+}
+
+func containsID(ids []int, id int) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// restrict returns the stories of order that are listed in ids.
+func restrict(order, ids []int) []int {
+	set := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	var restricted []int
+	for _, id := range order {
+		if set[id] {
+			restricted = append(restricted, id)
+		}
+	}
+	return restricted
+}
+
+func TestPlanReorder(t *testing.T) {
+	tests := []struct {
+		name    string
+		current []int
+		desired []int
+		moves   int
+	}{
+		{"empty", nil, nil, 0},
+		{"already ordered", []int{1, 2, 3, 4}, []int{1, 2, 3, 4}, 0},
+		{"move to top", []int{1, 2, 3, 4}, []int{4, 1, 2, 3}, 1},
+		{"move to bottom", []int{1, 2, 3, 4}, []int{2, 3, 4, 1}, 1},
+		{"swap neighbours", []int{1, 2, 3, 4}, []int{1, 3, 2, 4}, 1},
+		{"reverse", []int{1, 2, 3, 4}, []int{4, 3, 2, 1}, 3},
+		{"subset of current", []int{1, 2, 3, 4, 5}, []int{5, 3, 1}, 2},
+		{"new story at the end", []int{1, 2}, []int{1, 2, 3}, 1},
+		{"new story at the start", []int{1, 2}, []int{3, 1, 2}, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			moves := PlanReorder(test.current, test.desired)
+			if len(moves) != test.moves {
+				t.Errorf("PlanReorder() returned %v moves, want %v", len(moves), test.moves)
+			}
+			for _, move := range moves {
+				if (move.BeforeID == 0) == (move.AfterID == 0) {
+					t.Errorf("move %+v must set exactly one of BeforeID and AfterID", move)
+				}
+			}
+
+			// Stories new to the list start at the bottom.
+			order := append([]int(nil), test.current...)
+			for _, id := range test.desired {
+				if !containsID(order, id) {
+					order = append(order, id)
+				}
+			}
+			got := restrict(applyMoves(t, order, moves), test.desired)
+			if !reflect.DeepEqual(got, test.desired) {
+				t.Errorf("order after the moves = %v, want %v", got, test.desired)
+			}
+		})
+	}
+}
+
+func TestPlanReorderRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for n := 0; n < 500; n++ {
+		size := rnd.Intn(20) + 1
+		current := make([]int, size)
+		for i := range current {
+			current[i] = i + 1
+		}
+		rnd.Shuffle(len(current), func(i, j int) { current[i], current[j] = current[j], current[i] })
+
+		desired := append([]int(nil), current[:rnd.Intn(size)+1]...)
+		rnd.Shuffle(len(desired), func(i, j int) { desired[i], desired[j] = desired[j], desired[i] })
+
+		moves := PlanReorder(current, desired)
+		if got := restrict(applyMoves(t, current, moves), desired); !reflect.DeepEqual(got, desired) {
+			t.Fatalf("PlanReorder(%v, %v): order after the moves = %v", current, desired, got)
+		}
+		if want := len(desired) - longestIncreasingLength(current, desired); len(moves) != want {
+			t.Fatalf("PlanReorder(%v, %v) returned %v moves, want %v", current, desired, len(moves), want)
+		}
+	}
+}
+
+// longestIncreasingLength is the quadratic reference implementation
+// of the length of the longest subsequence of desired ordered in current.
+func longestIncreasingLength(current, desired []int) int {
+	positions := make(map[int]int, len(current))
+	for i, id := range current {
+		positions[id] = i
+	}
+	var (
+		lengths = make([]int, len(desired))
+		longest int
+	)
+	for i := range desired {
+		lengths[i] = 1
+		for j := 0; j < i; j++ {
+			if positions[desired[j]] < positions[desired[i]] && lengths[j]+1 > lengths[i] {
+				lengths[i] = lengths[j] + 1
+			}
+		}
+		if lengths[i] > longest {
+			longest = lengths[i]
+		}
+	}
+	return longest
+}
+
+func TestLongestOrderedSubsequence(t *testing.T) {
+	tests := []struct {
+		ids       []int
+		positions map[int]int
+		want      []bool
+	}{
+		{nil, nil, []bool{}},
+		{[]int{1, 2, 3}, map[int]int{1: 0, 2: 1, 3: 2}, []bool{true, true, true}},
+		{[]int{3, 1, 2}, map[int]int{1: 0, 2: 1, 3: 2}, []bool{false, true, true}},
+		{[]int{1, 9, 2}, map[int]int{1: 0, 2: 1}, []bool{true, false, true}},
+		{[]int{9}, map[int]int{}, []bool{false}},
+	}
+
+	for _, test := range tests {
+		if got := longestOrderedSubsequence(test.ids, test.positions); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("longestOrderedSubsequence(%v, %v) = %v, want %v", test.ids, test.positions, got, test.want)
+		}
+	}
+}
+
+func TestIterationStoryIDs(t *testing.T) {
+	tests := []struct {
+		name      string
+		iteration *Iteration
+		want      []int
+	}{
+		{"nested stories", &Iteration{Stories: []*Story{{ID: 3}, {ID: 1}, {ID: 2}}}, []int{3, 1, 2}},
+		{"story IDs only", &Iteration{StoryIDs: []int{4, 5}}, []int{4, 5}},
+		{"empty", &Iteration{}, nil},
+	}
+
+	for _, test := range tests {
+		if got := iterationStoryIDs(test.iteration); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%v: iterationStoryIDs() = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestStoryServiceMoveNilPosition(t *testing.T) {
+	client := NewClient("token")
+	if _, _, err := client.Stories.Move(1, 2, nil); err == nil {
+		t.Error("expected an error for a nil position")
+	}
+}
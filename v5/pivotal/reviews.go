@@ -0,0 +1,145 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	// ReviewStatusUnstarted wraps the review status enum in the variable name.
+	ReviewStatusUnstarted = "unstarted"
+	// ReviewStatusInReview wraps the review status enum in the variable name.
+	ReviewStatusInReview = "in_review"
+	// ReviewStatusPass wraps the review status enum in the variable name.
+	ReviewStatusPass = "pass"
+	// ReviewStatusRevise wraps the review status enum in the variable name.
+	ReviewStatusRevise = "revise"
+)
+
+// Review is the primary data object for the ReviewService.
+type Review struct {
+	ID           int         `json:"id,omitempty"`
+	StoryID      int         `json:"story_id,omitempty"`
+	ReviewTypeID int         `json:"review_type_id,omitempty"`
+	ReviewType   *ReviewType `json:"review_type,omitempty"`
+	ReviewerID   int         `json:"reviewer_id,omitempty"`
+	Status       string      `json:"status,omitempty"`
+	CreatedAt    *time.Time  `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time  `json:"updated_at,omitempty"`
+	Kind         string      `json:"kind,omitempty"`
+}
+
+// ReviewRequest is used to do Create/Update on reviews.
+type ReviewRequest struct {
+	ReviewTypeID int    `json:"review_type_id,omitempty"`
+	ReviewerID   *int   `json:"reviewer_id,omitempty"`
+	Status       string `json:"status,omitempty"`
+}
+
+// ReviewType is a kind of review that is configured for a project.
+type ReviewType struct {
+	ID        int        `json:"id,omitempty"`
+	ProjectID int        `json:"project_id,omitempty"`
+	Name      string     `json:"name,omitempty"`
+	Hidden    bool       `json:"hidden,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	Kind      string     `json:"kind,omitempty"`
+}
+
+// ReviewService wraps the client context for interacting with story reviews.
+type ReviewService struct {
+	client *Client
+}
+
+func newReviewService(client *Client) *ReviewService {
+	return &ReviewService{client}
+}
+
+// List returns the Reviews of a Story.
+func (service *ReviewService) List(projectID, storyID int) ([]*Review, *http.Response, error) {
+	u := fmt.Sprintf("projects/%v/stories/%v/reviews", projectID, storyID)
+	req, err := service.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var reviews []*Review
+	resp, err := service.client.Do(req, &reviews)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return reviews, resp, nil
+}
+
+// Create will add a new Review to a Story.
+func (service *ReviewService) Create(projectID, storyID int, review *ReviewRequest) (*Review, *http.Response, error) {
+	if review.ReviewTypeID == 0 {
+		return nil, nil, &ErrFieldNotSet{"review_type_id"}
+	}
+
+	u := fmt.Sprintf("projects/%v/stories/%v/reviews", projectID, storyID)
+	req, err := service.client.NewRequest("POST", u, review)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var newReview Review
+	resp, err := service.client.Do(req, &newReview)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &newReview, resp, nil
+}
+
+// Update will change an existing Review, e.g. to set its status.
+func (service *ReviewService) Update(projectID, storyID, reviewID int, review *ReviewRequest) (*Review, *http.Response, error) {
+	u := fmt.Sprintf("projects/%v/stories/%v/reviews/%v", projectID, storyID, reviewID)
+	req, err := service.client.NewRequest("PUT", u, review)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updatedReview Review
+	resp, err := service.client.Do(req, &updatedReview)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &updatedReview, resp, nil
+}
+
+// Delete will remove a Review from a Story.
+func (service *ReviewService) Delete(projectID, storyID, reviewID int) (*http.Response, error) {
+	u := fmt.Sprintf("projects/%v/stories/%v/reviews/%v", projectID, storyID, reviewID)
+	req, err := service.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.client.Do(req, nil)
+}
+
+// ListTypes returns the review types configured for a project.
+func (service *ReviewService) ListTypes(projectID int) ([]*ReviewType, *http.Response, error) {
+	u := fmt.Sprintf("projects/%v/review_types", projectID)
+	req, err := service.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var reviewTypes []*ReviewType
+	resp, err := service.client.Do(req, &reviewTypes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return reviewTypes, resp, nil
+}
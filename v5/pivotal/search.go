@@ -0,0 +1,121 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// SearchResults is the response of the search endpoint.
+type SearchResults struct {
+	Query   string              `json:"query,omitempty"`
+	Stories *StorySearchResults `json:"stories,omitempty"`
+	Epics   *EpicSearchResults  `json:"epics,omitempty"`
+	Kind    string              `json:"kind,omitempty"`
+}
+
+// StorySearchResults holds the stories matching a search query
+// together with the cumulative point counts.
+type StorySearchResults struct {
+	Stories              []*Story `json:"stories,omitempty"`
+	TotalHits            int      `json:"total_hits,omitempty"`
+	TotalHitsWithDone    int      `json:"total_hits_with_done,omitempty"`
+	TotalPoints          float64  `json:"total_points,omitempty"`
+	TotalPointsCompleted float64  `json:"total_points_completed,omitempty"`
+	Kind                 string   `json:"kind,omitempty"`
+}
+
+// EpicSearchResults holds the epics matching a search query.
+type EpicSearchResults struct {
+	Epics     []*Epic `json:"epics,omitempty"`
+	TotalHits int     `json:"total_hits,omitempty"`
+	Kind      string  `json:"kind,omitempty"`
+}
+
+// SearchService wraps the client context for searching projects.
+type SearchService struct {
+	client *Client
+}
+
+func newSearchService(client *Client) *SearchService {
+	return &SearchService{client}
+}
+
+// Search returns the stories and epics of a project matching the query.
+// The query uses the same syntax as the search box in Pivotal Tracker.
+func (service *SearchService) Search(projectID int, query string) (*SearchResults, *http.Response, error) {
+	if query == "" {
+		return nil, nil, &ErrFieldNotSet{"query"}
+	}
+
+	u := fmt.Sprintf("projects/%v/search?query=%v", projectID, url.QueryEscape(query))
+	req, err := service.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var results SearchResults
+	resp, err := service.client.Do(req, &results)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &results, resp, nil
+}
+
+// SearchHit is a single search result, exactly one of Story and Epic is set.
+type SearchHit struct {
+	Story *Story
+	Epic  *Epic
+}
+
+// SearchCursor is used to implement the iterator pattern over search results.
+type SearchCursor struct {
+	stories []*Story
+	epics   []*Epic
+}
+
+// Iterate returns a cursor over the given search results.
+// The epics are returned first, followed by the stories.
+func (results *SearchResults) Iterate() *SearchCursor {
+	c := &SearchCursor{}
+	if results.Epics != nil {
+		c.epics = results.Epics.Epics
+	}
+	if results.Stories != nil {
+		c.stories = results.Stories.Stories
+	}
+	return c
+}
+
+// Next returns the next search hit.
+//
+// In case there are no more hits, io.EOF is returned as an error.
+func (c *SearchCursor) Next() (hit *SearchHit, err error) {
+	switch {
+	case len(c.epics) != 0:
+		hit = &SearchHit{Epic: c.epics[0]}
+		c.epics = c.epics[1:]
+	case len(c.stories) != 0:
+		hit = &SearchHit{Story: c.stories[0]}
+		c.stories = c.stories[1:]
+	default:
+		return nil, io.EOF
+	}
+	return hit, nil
+}
+
+// Iterate runs the search and returns a cursor over both the epics and
+// the stories found, see SearchResults.Iterate.
+func (service *SearchService) Iterate(projectID int, query string) (*SearchCursor, error) {
+	results, _, err := service.Search(projectID, query)
+	if err != nil {
+		return nil, err
+	}
+	return results.Iterate(), nil
+}
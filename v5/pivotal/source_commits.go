@@ -0,0 +1,131 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SourceCommit is the commit payload accepted by the source_commits endpoint.
+type SourceCommit struct {
+	Message  string `json:"message,omitempty"`
+	Author   string `json:"author,omitempty"`
+	CommitID string `json:"commit_id,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+type sourceCommitRequest struct {
+	SourceCommit *SourceCommit `json:"source_commit"`
+}
+
+// SourceCommitService wraps the client context for posting source commits.
+type SourceCommitService struct {
+	client *Client
+}
+
+func newSourceCommitService(client *Client) *SourceCommitService {
+	return &SourceCommitService{client}
+}
+
+// Create posts a source commit to Pivotal Tracker. Tracker parses the commit
+// message and comments on (and possibly changes the state of) the referenced
+// stories. The comments created are returned.
+func (service *SourceCommitService) Create(commit *SourceCommit) ([]*Comment, *http.Response, error) {
+	if commit.Message == "" {
+		return nil, nil, &ErrFieldNotSet{"message"}
+	}
+
+	req, err := service.client.NewRequest("POST", "source_commits", &sourceCommitRequest{commit})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var comments []*Comment
+	resp, err := service.client.Do(req, &comments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return comments, resp, nil
+}
+
+// CommitAction is a story reference found in a commit message.
+type CommitAction struct {
+	// StoryID is the ID of the referenced story.
+	StoryID int
+	// Verb is the lowercased state verb, e.g. "finishes", or an empty string
+	// in case the story is only referenced.
+	Verb string
+	// State is the state the story is moved into by Tracker, e.g. StoryStateFinished,
+	// or an empty string in case the story is only referenced.
+	State StoryState
+}
+
+// TargetState returns the state a story of the given type ends up in.
+// Chores are accepted right away since they cannot be delivered.
+func (action *CommitAction) TargetState(storyType StoryType) StoryState {
+	if storyType == StoryTypeChore && action.State != "" {
+		return StoryStateAccepted
+	}
+	return action.State
+}
+
+var (
+	commitBracketRegexp = regexp.MustCompile(`\[([^\[\]]*#\d+[^\[\]]*)\]`)
+	commitStoryIDRegexp = regexp.MustCompile(`#(\d+)`)
+)
+
+var commitVerbStates = map[string]StoryState{
+	"fix":       StoryStateFinished,
+	"fixed":     StoryStateFinished,
+	"fixes":     StoryStateFinished,
+	"finish":    StoryStateFinished,
+	"finished":  StoryStateFinished,
+	"finishes":  StoryStateFinished,
+	"complete":  StoryStateFinished,
+	"completed": StoryStateFinished,
+	"completes": StoryStateFinished,
+	"deliver":   StoryStateDelivered,
+	"delivered": StoryStateDelivered,
+	"delivers":  StoryStateDelivered,
+}
+
+// ParseCommitMessage extracts the story references from a commit message
+// the same way Tracker does, e.g. "[Finishes #123 #456]" or "[#789]".
+// The actions are returned in the order they appear in the message.
+func ParseCommitMessage(message string) []*CommitAction {
+	var actions []*CommitAction
+	for _, match := range commitBracketRegexp.FindAllStringSubmatch(message, -1) {
+		content := match[1]
+
+		var (
+			verb  string
+			state StoryState
+		)
+		for _, word := range strings.Fields(content) {
+			word = strings.ToLower(strings.Trim(word, ",:"))
+			if s, ok := commitVerbStates[word]; ok {
+				verb, state = word, s
+				break
+			}
+		}
+
+		for _, idMatch := range commitStoryIDRegexp.FindAllStringSubmatch(content, -1) {
+			id, err := strconv.Atoi(idMatch[1])
+			if err != nil {
+				continue
+			}
+			actions = append(actions, &CommitAction{
+				StoryID: id,
+				Verb:    verb,
+				State:   state,
+			})
+		}
+	}
+	return actions
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCommitMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    []*CommitAction
+	}{
+		{
+			name:    "no reference",
+			message: "Fix the build",
+		},
+		{
+			name:    "reference only",
+			message: "Refactor the parser [#123]",
+			want:    []*CommitAction{{StoryID: 123}},
+		},
+		{
+			name:    "verb with multiple stories",
+			message: "[Finishes #123 #456] Add the parser",
+			want: []*CommitAction{
+				{StoryID: 123, Verb: "finishes", State: StoryStateFinished},
+				{StoryID: 456, Verb: "finishes", State: StoryStateFinished},
+			},
+		},
+		{
+			name:    "verb is case insensitive",
+			message: "[DELIVERS #1]",
+			want:    []*CommitAction{{StoryID: 1, Verb: "delivers", State: StoryStateDelivered}},
+		},
+		{
+			name:    "punctuation around the verb",
+			message: "[fixed: #7, #8]",
+			want: []*CommitAction{
+				{StoryID: 7, Verb: "fixed", State: StoryStateFinished},
+				{StoryID: 8, Verb: "fixed", State: StoryStateFinished},
+			},
+		},
+		{
+			name:    "multiple brackets in order",
+			message: "[Completes #1] and [#2] then [Delivered #3]",
+			want: []*CommitAction{
+				{StoryID: 1, Verb: "completes", State: StoryStateFinished},
+				{StoryID: 2},
+				{StoryID: 3, Verb: "delivered", State: StoryStateDelivered},
+			},
+		},
+		{
+			name:    "unknown verb",
+			message: "[Starts #5]",
+			want:    []*CommitAction{{StoryID: 5}},
+		},
+		{
+			name:    "brackets without a story ID",
+			message: "[WIP] [skip ci] #9",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := ParseCommitMessage(test.message)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ParseCommitMessage(%q) = %v, want %v", test.message, actionValues(got), actionValues(test.want))
+			}
+		})
+	}
+}
+
+func TestCommitActionTargetState(t *testing.T) {
+	tests := []struct {
+		action    CommitAction
+		storyType StoryType
+		want      StoryState
+	}{
+		{CommitAction{StoryID: 1, State: StoryStateFinished}, StoryTypeFeature, StoryStateFinished},
+		{CommitAction{StoryID: 1, State: StoryStateDelivered}, StoryTypeBug, StoryStateDelivered},
+		{CommitAction{StoryID: 1, State: StoryStateFinished}, StoryTypeChore, StoryStateAccepted},
+		{CommitAction{StoryID: 1}, StoryTypeChore, ""},
+	}
+
+	for _, test := range tests {
+		if got := test.action.TargetState(test.storyType); got != test.want {
+			t.Errorf("TargetState(%v) of %+v = %q, want %q", test.storyType, test.action, got, test.want)
+		}
+	}
+}
+
+func actionValues(actions []*CommitAction) []CommitAction {
+	values := make([]CommitAction, 0, len(actions))
+	for _, action := range actions {
+		values = append(values, *action)
+	}
+	return values
+}
@@ -6,6 +6,7 @@
 package pivotal
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -16,79 +17,171 @@ import (
 // PageLimit is the number of items to fetch at once when getting paginated response.
 const PageLimit = 10
 
+// StoryType casts string values of story types.
+type StoryType string
+
 const (
 	// StoryTypeFeature wraps the string enum in the variable name.
-	StoryTypeFeature = "feature"
+	StoryTypeFeature StoryType = "feature"
 	// StoryTypeBug wraps the string enum in the variable name.
-	StoryTypeBug = "bug"
+	StoryTypeBug StoryType = "bug"
 	// StoryTypeChore wraps the string enum in the variable name.
-	StoryTypeChore = "chore"
+	StoryTypeChore StoryType = "chore"
 	// StoryTypeRelease wraps the string enum in the variable name.
-	StoryTypeRelease = "release"
+	StoryTypeRelease StoryType = "release"
 )
 
+// StoryState casts string values of story states.
+type StoryState string
+
 const (
 	// StoryStateUnscheduled wraps the story state enum in the variable name.
-	StoryStateUnscheduled = "unscheduled"
+	StoryStateUnscheduled StoryState = "unscheduled"
 	// StoryStatePlanned wraps the story state enum in the variable name.
-	StoryStatePlanned = "planned"
+	StoryStatePlanned StoryState = "planned"
 	// StoryStateUnstarted wraps the story state enum in the variable name.
-	StoryStateUnstarted = "unstarted"
+	StoryStateUnstarted StoryState = "unstarted"
 	// StoryStateStarted wraps the story state enum in the variable name.
-	StoryStateStarted = "started"
+	StoryStateStarted StoryState = "started"
 	// StoryStateFinished wraps the story state enum in the variable name.
-	StoryStateFinished = "finished"
+	StoryStateFinished StoryState = "finished"
 	// StoryStateDelivered wraps the story state enum in the variable name.
-	StoryStateDelivered = "delivered"
+	StoryStateDelivered StoryState = "delivered"
 	// StoryStateAccepted wraps the story state enum in the variable name.
-	StoryStateAccepted = "accepted"
+	StoryStateAccepted StoryState = "accepted"
 	// StoryStateRejected wraps the story state enum in the variable name.
-	StoryStateRejected = "rejected"
+	StoryStateRejected StoryState = "rejected"
 )
 
 // Story is the top level data object for a story, it wraps multiple child objects
 // but is the primary required for interacting with the StoryService.
+//
+// The nested resources such as Owners, Tasks or Comments are only set
+// when requested using a FieldSelector, see ExpandedStoryFields.
 type Story struct {
-	ID            int        `json:"id,omitempty"`
-	ProjectID     int        `json:"project_id,omitempty"`
-	Name          string     `json:"name,omitempty"`
-	Description   string     `json:"description,omitempty"`
-	Type          string     `json:"story_type,omitempty"`
-	State         string     `json:"current_state,omitempty"`
-	Estimate      *float64   `json:"estimate,omitempty"`
-	AcceptedAt    *time.Time `json:"accepted_at,omitempty"`
-	Deadline      *time.Time `json:"deadline,omitempty"`
-	RequestedByID int        `json:"requested_by_id,omitempty"`
-	OwnerIDs      []int      `json:"owner_ids,omitempty"`
-	LabelIDs      []int      `json:"label_ids,omitempty"`
-	Labels        []*Label   `json:"labels,omitempty"`
-	TaskIDs       []int      `json:"task_ids,omitempty"`
-	Tasks         []int      `json:"tasks,omitempty"`
-	FollowerIDs   []int      `json:"follower_ids,omitempty"`
-	CommentIDs    []int      `json:"comment_ids,omitempty"`
-	CreatedAt     *time.Time `json:"created_at,omitempty"`
-	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
-	BeforeID      int        `json:"before_id,omitempty"`
-	AfterID       int        `json:"after_id,omitempty"`
-	IntegrationID int        `json:"integration_id,omitempty"`
-	ExternalID    string     `json:"external_id,omitempty"`
-	URL           string     `json:"url,omitempty"`
+	ID              int            `json:"id,omitempty"`
+	ProjectID       int            `json:"project_id,omitempty"`
+	Name            string         `json:"name,omitempty"`
+	Description     string         `json:"description,omitempty"`
+	Type            StoryType      `json:"story_type,omitempty"`
+	State           StoryState     `json:"current_state,omitempty"`
+	Estimate        *float64       `json:"estimate,omitempty"`
+	AcceptedAt      *time.Time     `json:"accepted_at,omitempty"`
+	Deadline        *time.Time     `json:"deadline,omitempty"`
+	RequestedByID   int            `json:"requested_by_id,omitempty"`
+	RequestedBy     *Person        `json:"requested_by,omitempty"`
+	OwnerIDs        []int          `json:"owner_ids,omitempty"`
+	Owners          []*Person      `json:"owners,omitempty"`
+	LabelIDs        []int          `json:"label_ids,omitempty"`
+	Labels          []*Label       `json:"labels,omitempty"`
+	TaskIDs         []int          `json:"task_ids,omitempty"`
+	Tasks           []*Task        `json:"tasks,omitempty"`
+	FollowerIDs     []int          `json:"follower_ids,omitempty"`
+	Followers       []*Person      `json:"followers,omitempty"`
+	CommentIDs      []int          `json:"comment_ids,omitempty"`
+	Comments        []*Comment     `json:"comments,omitempty"`
+	BlockerIDs      []int          `json:"blocker_ids,omitempty"`
+	Blockers        []*Blocker     `json:"blockers,omitempty"`
+	BlockedStoryIDs []int          `json:"blocked_story_ids,omitempty"`
+	CreatedAt       *time.Time     `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time     `json:"updated_at,omitempty"`
+	BeforeID        int            `json:"before_id,omitempty"`
+	AfterID         int            `json:"after_id,omitempty"`
+	IntegrationID   int            `json:"integration_id,omitempty"`
+	ExternalID      string         `json:"external_id,omitempty"`
+	ReviewIDs       []int          `json:"review_ids,omitempty"`
+	Reviews         []*Review      `json:"reviews,omitempty"`
+	PullRequestIDs  []int          `json:"pull_request_ids,omitempty"`
+	PullRequests    []*PullRequest `json:"pull_requests,omitempty"`
+	BranchIDs       []int          `json:"branch_ids,omitempty"`
+	Branches        []*Branch      `json:"branches,omitempty"`
+	URL             string         `json:"url,omitempty"`
 }
 
 // StoryRequest is a simplified Story object for use in Create/Update/Delete operations.
+//
+// All the fields are pointers so that a field that is not set is not sent at all,
+// while a field set to a zero value, e.g. an empty description, is sent as such.
+// Use the String, Int, Float64, Bool, Time and Ints helpers to fill in the fields.
+// To send an explicit null, e.g. to clear the estimate or the deadline,
+// list the field in Null using the StoryNull* constants.
 type StoryRequest struct {
-	Name        string    `json:"name,omitempty"`
-	Description string    `json:"description,omitempty"`
-	Type        string    `json:"story_type,omitempty"`
-	State       string    `json:"current_state,omitempty"`
-	Estimate    *float64  `json:"estimate,omitempty"`
-	OwnerIDs    *[]int    `json:"owner_ids,omitempty"`
-	LabelIDs    *[]int    `json:"label_ids,omitempty"`
-	Labels      *[]*Label `json:"labels,omitempty"`
-	TaskIDs     *[]int    `json:"task_ids,omitempty"`
-	Tasks       *[]int    `json:"tasks,omitempty"`
-	FollowerIDs *[]int    `json:"follower_ids,omitempty"`
-	CommentIDs  *[]int    `json:"comment_ids,omitempty"`
+	Name          *string                `json:"name,omitempty"`
+	Description   *string                `json:"description,omitempty"`
+	Type          *StoryType             `json:"story_type,omitempty"`
+	State         *StoryState            `json:"current_state,omitempty"`
+	Estimate      *float64               `json:"estimate,omitempty"`
+	AcceptedAt    *time.Time             `json:"accepted_at,omitempty"`
+	Deadline      *time.Time             `json:"deadline,omitempty"`
+	CreatedAt     *time.Time             `json:"created_at,omitempty"`
+	RequestedByID *int                   `json:"requested_by_id,omitempty"`
+	OwnerIDs      *[]int                 `json:"owner_ids,omitempty"`
+	LabelIDs      *[]int                 `json:"label_ids,omitempty"`
+	Labels        *[]*Label              `json:"labels,omitempty"`
+	TaskIDs       *[]int                 `json:"task_ids,omitempty"`
+	Tasks         *[]*Task               `json:"tasks,omitempty"`
+	FollowerIDs   *[]int                 `json:"follower_ids,omitempty"`
+	CommentIDs    *[]int                 `json:"comment_ids,omitempty"`
+	Comments      *[]*Comment            `json:"comments,omitempty"`
+	Reviews       *[]*ReviewRequest      `json:"reviews,omitempty"`
+	PullRequests  *[]*PullRequestRequest `json:"pull_requests,omitempty"`
+	Branches      *[]*BranchRequest      `json:"branches,omitempty"`
+	BeforeID      *int                   `json:"before_id,omitempty"`
+	AfterID       *int                   `json:"after_id,omitempty"`
+	ProjectID     *int                   `json:"project_id,omitempty"`
+	IntegrationID *int                   `json:"integration_id,omitempty"`
+	ExternalID    *string                `json:"external_id,omitempty"`
+
+	// Null lists the fields to be sent as null, see the StoryNull* constants.
+	// Null takes precedence over the field values.
+	Null []StoryNullField `json:"-"`
+}
+
+// StoryNullField is the JSON name of a StoryRequest field that can be sent as null.
+type StoryNullField string
+
+// The StoryRequest fields Pivotal Tracker accepts as null.
+const (
+	// StoryNullEstimate clears the estimate, making the story unestimated.
+	StoryNullEstimate StoryNullField = "estimate"
+	// StoryNullDeadline clears the deadline of a release marker.
+	StoryNullDeadline StoryNullField = "deadline"
+	// StoryNullIntegrationID detaches the story from an integration.
+	StoryNullIntegrationID StoryNullField = "integration_id"
+	// StoryNullExternalID clears the ID of the story in the integration.
+	StoryNullExternalID StoryNullField = "external_id"
+)
+
+// Valid reports whether the field is one of the StoryNull* fields.
+func (field StoryNullField) Valid() bool {
+	switch field {
+	case StoryNullEstimate, StoryNullDeadline, StoryNullIntegrationID, StoryNullExternalID:
+		return true
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler() interface for the StoryRequest object
+// so that the fields listed in Null are sent as null. Fields that cannot be
+// sent as null are rejected with an *ErrInvalidValue.
+func (story StoryRequest) MarshalJSON() ([]byte, error) {
+	type storyRequest StoryRequest
+	content, err := json.Marshal(storyRequest(story))
+	if err != nil || len(story.Null) == 0 {
+		return content, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(content, &fields); err != nil {
+		return nil, err
+	}
+	for _, field := range story.Null {
+		if !field.Valid() {
+			return nil, &ErrInvalidValue{"null", field}
+		}
+		fields[string(field)] = json.RawMessage("null")
+	}
+	return json.Marshal(fields)
 }
 
 // Label is a child object of a Story. This may need to be broken out into a LabelService
@@ -123,19 +216,21 @@ type Person struct {
 	Kind     string `json:"kind,omitempty"`
 }
 
-// Comment is used to show all comments associated with a Story.
+// Comment is used to show all comments associated with a Story or an Epic.
 type Comment struct {
-	ID                  int        `json:"id,omitempty"`
-	StoryID             int        `json:"story_id,omitempty"`
-	EpicID              int        `json:"epic_id,omitempty"`
-	PersonID            int        `json:"person_id,omitempty"`
-	Text                string     `json:"text,omitempty"`
-	FileAttachmentIDs   []int      `json:"file_attachment_ids,omitempty"`
-	GoogleAttachmentIDs []int      `json:"google_attachment_ids,omitempty"`
-	CommitType          string     `json:"commit_type,omitempty"`
-	CommitIdentifier    string     `json:"commit_identifier,omitempty"`
-	CreatedAt           *time.Time `json:"created_at,omitempty"`
-	UpdatedAt           *time.Time `json:"updated_at,omitempty"`
+	ID                  int               `json:"id,omitempty"`
+	StoryID             int               `json:"story_id,omitempty"`
+	EpicID              int               `json:"epic_id,omitempty"`
+	PersonID            int               `json:"person_id,omitempty"`
+	Person              *Person           `json:"person,omitempty"`
+	Text                string            `json:"text,omitempty"`
+	FileAttachmentIDs   []int             `json:"file_attachment_ids,omitempty"`
+	FileAttachments     []*FileAttachment `json:"file_attachments,omitempty"`
+	GoogleAttachmentIDs []int             `json:"google_attachment_ids,omitempty"`
+	CommitType          string            `json:"commit_type,omitempty"`
+	CommitIdentifier    string            `json:"commit_identifier,omitempty"`
+	CreatedAt           *time.Time        `json:"created_at,omitempty"`
+	UpdatedAt           *time.Time        `json:"updated_at,omitempty"`
 }
 
 // Blocker shows the relationship between other Stories and blocking states.
@@ -174,7 +269,13 @@ func newStoryService(client *Client) *StoryService {
 // is not always sorted when using a filter, this approach is required to get
 // the right data. Not sure whether this is a bug or a feature.
 func (service *StoryService) List(projectID int, filter string) ([]*Story, error) {
-	reqFunc := newStoriesRequestFunc(service.client, projectID, filter)
+	return service.ListWithFields(projectID, filter, nil)
+}
+
+// ListWithFields is the same as List, but the stories returned contain
+// only the fields selected, including nested resources.
+func (service *StoryService) ListWithFields(projectID int, filter string, fields *FieldSelector) ([]*Story, error) {
+	reqFunc := newStoriesRequestFunc(service.client, projectID, filter, fields)
 	cursor, err := newCursor(service.client, reqFunc, 0)
 	if err != nil {
 		return nil, err
@@ -187,11 +288,18 @@ func (service *StoryService) List(projectID int, filter string) ([]*Story, error
 	return stories, nil
 }
 
-func newStoriesRequestFunc(client *Client, projectID int, filter string) func() *http.Request {
+func newStoriesRequestFunc(client *Client, projectID int, filter string, fields *FieldSelector) func() *http.Request {
 	return func() *http.Request {
 		u := fmt.Sprintf("projects/%v/stories", projectID)
+		queryParams := url.Values{}
 		if filter != "" {
-			u += "?filter=" + url.QueryEscape(filter)
+			queryParams.Add("filter", filter)
+		}
+		if s := fields.String(); s != "" {
+			queryParams.Add("fields", s)
+		}
+		if len(queryParams) > 0 {
+			u += "?" + queryParams.Encode()
 		}
 		req, _ := client.NewRequest("GET", u, nil)
 		return req
@@ -226,7 +334,13 @@ func (c *StoryCursor) Next() (s *Story, err error) {
 // Iterate returns a cursor that can be used to iterate over the stories specified
 // by the filter. More stories are fetched on demand as needed.
 func (service *StoryService) Iterate(projectID int, filter string) (c *StoryCursor, err error) {
-	reqFunc := newStoriesRequestFunc(service.client, projectID, filter)
+	return service.IterateWithFields(projectID, filter, nil)
+}
+
+// IterateWithFields is the same as Iterate, but the stories returned contain
+// only the fields selected, including nested resources.
+func (service *StoryService) IterateWithFields(projectID int, filter string, fields *FieldSelector) (c *StoryCursor, err error) {
+	reqFunc := newStoriesRequestFunc(service.client, projectID, filter, fields)
 	cursor, err := newCursor(service.client, reqFunc, PageLimit)
 	if err != nil {
 		return nil, err
@@ -240,10 +354,18 @@ func (service *StoryService) Create(projectID int, story *StoryRequest) (*Story,
 		return nil, nil, &ErrFieldNotSet{"project_id"}
 	}
 
-	if story.Name == "" {
+	if story == nil || story.Name == nil || *story.Name == "" {
 		return nil, nil, &ErrFieldNotSet{"name"}
 	}
 
+	if err := story.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := service.validateEstimate(projectID, 0, story); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("projects/%v/stories", projectID)
 	req, err := service.client.NewRequest("POST", u, story)
 	if err != nil {
@@ -262,7 +384,13 @@ func (service *StoryService) Create(projectID int, story *StoryRequest) (*Story,
 
 // Get will obtain the details about a single Story by project and story ID.
 func (service *StoryService) Get(projectID, storyID int) (*Story, *http.Response, error) {
-	u := fmt.Sprintf("projects/%v/stories/%v", projectID, storyID)
+	return service.GetWithFields(projectID, storyID, nil)
+}
+
+// GetWithFields is the same as Get, but the story returned contains
+// only the fields selected, including nested resources.
+func (service *StoryService) GetWithFields(projectID, storyID int, fields *FieldSelector) (*Story, *http.Response, error) {
+	u := withFields(fmt.Sprintf("projects/%v/stories/%v", projectID, storyID), fields)
 	req, err := service.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -279,7 +407,13 @@ func (service *StoryService) Get(projectID, storyID int) (*Story, *http.Response
 
 // GetByID will obtain the details about a single Story by the story ID only.
 func (service *StoryService) GetByID(storyID int) (*Story, *http.Response, error) {
-	u := fmt.Sprintf("stories/%d", storyID)
+	return service.GetByIDWithFields(storyID, nil)
+}
+
+// GetByIDWithFields is the same as GetByID, but the story returned contains
+// only the fields selected, including nested resources.
+func (service *StoryService) GetByIDWithFields(storyID int, fields *FieldSelector) (*Story, *http.Response, error) {
+	u := withFields(fmt.Sprintf("stories/%d", storyID), fields)
 	req, err := service.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -294,8 +428,48 @@ func (service *StoryService) GetByID(storyID int) (*Story, *http.Response, error
 	return &story, resp, err
 }
 
+// ExpandedStoryFields returns a FieldSelector selecting the default story fields
+// together with all the nested resources, i.e. the people involved, tasks,
+// comments, blockers, labels, reviews, pull requests and branches.
+func ExpandedStoryFields() *FieldSelector {
+	return Fields(
+		FieldsDefault,
+		"requested_by",
+		"owners",
+		"followers",
+		"tasks",
+		"blockers",
+		"reviews",
+		"pull_requests",
+		"branches",
+	).Nested("comments", Fields(FieldsDefault, "person", "file_attachments"))
+}
+
+// GetExpanded will obtain a single Story including all its nested resources,
+// see ExpandedStoryFields.
+func (service *StoryService) GetExpanded(projectID, storyID int) (*Story, *http.Response, error) {
+	return service.GetWithFields(projectID, storyID, ExpandedStoryFields())
+}
+
 // Update will change details of an existing story.
+//
+// The story type and state are checked to be valid values before the request
+// is sent. Since the current state of the story is not known, the transition
+// itself is left to be checked by Pivotal Tracker, see ValidateTransition.
+// The estimate is checked against the project point scale, see ValidateEstimate.
 func (service *StoryService) Update(projectID, storyID int, story *StoryRequest) (*Story, *http.Response, error) {
+	if story == nil {
+		return nil, nil, &ErrFieldNotSet{"story"}
+	}
+
+	if err := story.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := service.validateEstimate(projectID, storyID, story); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("projects/%v/stories/%v", projectID, storyID)
 	req, err := service.client.NewRequest("PUT", u, story)
 	if err != nil {
@@ -344,6 +518,17 @@ func (service *StoryService) AddTask(projectID, storyID int, task *Task) (*http.
 	return service.client.Do(req, nil)
 }
 
+// DeleteTask will remove a Task from a Story.
+func (service *StoryService) DeleteTask(projectID, storyID, taskID int) (*http.Response, error) {
+	u := fmt.Sprintf("projects/%v/stories/%v/tasks/%v", projectID, storyID, taskID)
+	req, err := service.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.client.Do(req, nil)
+}
+
 // ListOwners will show who is assigned to a story, returning a Person array.
 func (service *StoryService) ListOwners(projectID, storyID int) ([]*Person, *http.Response, error) {
 	u := fmt.Sprintf("projects/%d/stories/%d/owners", projectID, storyID)
@@ -404,6 +589,40 @@ func (service *StoryService) ListComments(
 	return comments, resp, nil
 }
 
+// UpdateComment will change the text or the attachments of an existing Comment.
+func (service *StoryService) UpdateComment(
+	projectID int,
+	storyID int,
+	commentID int,
+	comment *Comment,
+) (*Comment, *http.Response, error) {
+
+	u := fmt.Sprintf("projects/%v/stories/%v/comments/%v", projectID, storyID, commentID)
+	req, err := service.client.NewRequest("PUT", u, comment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updatedComment Comment
+	resp, err := service.client.Do(req, &updatedComment)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &updatedComment, resp, nil
+}
+
+// DeleteComment will remove a Comment from a Story.
+func (service *StoryService) DeleteComment(projectID, storyID, commentID int) (*http.Response, error) {
+	u := fmt.Sprintf("projects/%v/stories/%v/comments/%v", projectID, storyID, commentID)
+	req, err := service.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.client.Do(req, nil)
+}
+
 // ListBlockers returns the list of Blockers in a Story.
 func (service *StoryService) ListBlockers(
 	projectID int,
@@ -460,3 +679,14 @@ func (service *StoryService) UpdateBlocker(projectID, storyID, blockerID int, bl
 
 	return &blockerResp, resp, nil
 }
+
+// DeleteBlocker will remove a Blocker from a Story.
+func (service *StoryService) DeleteBlocker(projectID, storyID, blockerID int) (*http.Response, error) {
+	u := fmt.Sprintf("projects/%v/stories/%v/blockers/%v", projectID, storyID, blockerID)
+	req, err := service.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.client.Do(req, nil)
+}
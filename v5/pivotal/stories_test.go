@@ -0,0 +1,87 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestStoryRequestMarshalJSON(t *testing.T) {
+	deadline := time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name    string
+		request StoryRequest
+		want    string
+	}{
+		{
+			name: "empty",
+			want: `{}`,
+		},
+		{
+			name:    "zero values are sent",
+			request: StoryRequest{Description: String(""), Estimate: Float64(0), OwnerIDs: Ints()},
+			want:    `{"description":"","estimate":0,"owner_ids":[]}`,
+		},
+		{
+			name:    "fields set",
+			request: StoryRequest{Name: String("Story"), Deadline: Time(deadline)},
+			want:    `{"name":"Story","deadline":"2018-01-02T03:04:05Z"}`,
+		},
+		{
+			name:    "null",
+			request: StoryRequest{Name: String("Story"), Null: []StoryNullField{StoryNullEstimate, StoryNullDeadline}},
+			want:    `{"deadline":null,"estimate":null,"name":"Story"}`,
+		},
+		{
+			name: "null takes precedence",
+			request: StoryRequest{
+				Estimate:   Float64(3),
+				ExternalID: String("EXT-1"),
+				Null:       []StoryNullField{StoryNullEstimate, StoryNullExternalID, StoryNullIntegrationID},
+			},
+			want: `{"estimate":null,"external_id":null,"integration_id":null}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			content, err := json.Marshal(test.request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := string(content); got != test.want {
+				t.Errorf("json.Marshal() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestStoryRequestMarshalJSONInvalidNull(t *testing.T) {
+	for _, field := range []StoryNullField{"estimat", "name", "owner_ids", ""} {
+		request := StoryRequest{Null: []StoryNullField{field}}
+		if _, err := json.Marshal(request); err == nil {
+			t.Errorf("json.Marshal() with Null %q: expected an error", field)
+		}
+		if err := request.validate(); err == nil {
+			t.Errorf("validate() with Null %q: expected an error", field)
+		}
+	}
+}
+
+func TestStoryServiceNilRequest(t *testing.T) {
+	client := newTestClient(t, testRoutes(t, nil))
+
+	if _, _, err := client.Stories.Create(1, nil); err == nil {
+		t.Error("Create: expected an error for a nil request")
+	}
+	if _, _, err := client.Stories.Update(1, 2, nil); err == nil {
+		t.Error("Update: expected an error for a nil request")
+	}
+	if _, err := client.Stories.BulkUpdate(1, []int{2, 3}, nil, nil); err == nil {
+		t.Error("BulkUpdate: expected an error for a nil patch")
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+// Valid returns true in case the story type is one of the StoryType* constants.
+func (storyType StoryType) Valid() bool {
+	switch storyType {
+	case StoryTypeFeature, StoryTypeBug, StoryTypeChore, StoryTypeRelease:
+		return true
+	}
+	return false
+}
+
+// Valid returns true in case the story state is one of the StoryState* constants.
+func (state StoryState) Valid() bool {
+	switch state {
+	case StoryStateUnscheduled, StoryStatePlanned, StoryStateUnstarted, StoryStateStarted,
+		StoryStateFinished, StoryStateDelivered, StoryStateAccepted, StoryStateRejected:
+		return true
+	}
+	return false
+}
+
+// storyTransitions lists the states a feature or a bug can move into from a given state.
+var storyTransitions = map[StoryState][]StoryState{
+	StoryStateUnscheduled: {StoryStatePlanned, StoryStateUnstarted, StoryStateStarted},
+	StoryStatePlanned:     {StoryStateUnscheduled, StoryStateUnstarted, StoryStateStarted},
+	StoryStateUnstarted:   {StoryStateUnscheduled, StoryStatePlanned, StoryStateStarted},
+	StoryStateStarted:     {StoryStateUnstarted, StoryStateFinished},
+	StoryStateFinished:    {StoryStateStarted, StoryStateDelivered},
+	StoryStateDelivered:   {StoryStateAccepted, StoryStateRejected},
+	StoryStateRejected:    {StoryStateStarted},
+	StoryStateAccepted:    {StoryStateStarted},
+}
+
+// choreTransitions lists the states a chore can move into from a given state.
+// Chores are accepted right away once done, they are never finished or delivered.
+var choreTransitions = map[StoryState][]StoryState{
+	StoryStateUnscheduled: {StoryStatePlanned, StoryStateUnstarted, StoryStateStarted},
+	StoryStatePlanned:     {StoryStateUnscheduled, StoryStateUnstarted, StoryStateStarted},
+	StoryStateUnstarted:   {StoryStateUnscheduled, StoryStatePlanned, StoryStateStarted},
+	StoryStateStarted:     {StoryStateUnstarted, StoryStateAccepted},
+	StoryStateAccepted:    {StoryStateStarted},
+}
+
+// releaseTransitions lists the states a release can move into from a given state.
+var releaseTransitions = map[StoryState][]StoryState{
+	StoryStateUnscheduled: {StoryStatePlanned, StoryStateUnstarted, StoryStateAccepted},
+	StoryStatePlanned:     {StoryStateUnscheduled, StoryStateUnstarted, StoryStateAccepted},
+	StoryStateUnstarted:   {StoryStateUnscheduled, StoryStatePlanned, StoryStateAccepted},
+	StoryStateAccepted:    {StoryStateUnstarted},
+}
+
+// NextStates returns the states a story of the given type can move into
+// from the given state.
+func NextStates(storyType StoryType, from StoryState) []StoryState {
+	switch storyType {
+	case StoryTypeChore:
+		return choreTransitions[from]
+	case StoryTypeRelease:
+		return releaseTransitions[from]
+	default:
+		return storyTransitions[from]
+	}
+}
+
+// ValidateTransition checks whether a story of the given type can move
+// from one state to another. Staying in the same state is always allowed.
+func ValidateTransition(storyType StoryType, from, to StoryState) error {
+	if !storyType.Valid() {
+		return &ErrInvalidValue{"story_type", storyType}
+	}
+	if !to.Valid() {
+		return &ErrInvalidValue{"current_state", to}
+	}
+	if from == to {
+		return nil
+	}
+	for _, state := range NextStates(storyType, from) {
+		if state == to {
+			return nil
+		}
+	}
+	return &ErrInvalidTransition{storyType, from, to}
+}
+
+// validate checks the values of the enum fields and the null fields of the request.
+func (story *StoryRequest) validate() error {
+	if story.Type != nil && !story.Type.Valid() {
+		return &ErrInvalidValue{"story_type", *story.Type}
+	}
+	if story.State != nil && !story.State.Valid() {
+		return &ErrInvalidValue{"current_state", *story.State}
+	}
+	for _, field := range story.Null {
+		if !field.Valid() {
+			return &ErrInvalidValue{"null", field}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// StoryTransition records a story entering a new state.
+type StoryTransition struct {
+	State          StoryState `json:"state,omitempty"`
+	StoryID        int        `json:"story_id,omitempty"`
+	ProjectID      int        `json:"project_id,omitempty"`
+	ProjectVersion int        `json:"project_version,omitempty"`
+	OccurredAt     *time.Time `json:"occurred_at,omitempty"`
+	PerformedByID  int        `json:"performed_by_id,omitempty"`
+	Kind           string     `json:"kind,omitempty"`
+}
+
+// ListTransitions returns the state transitions of a single Story.
+func (service *StoryService) ListTransitions(projectID, storyID int) ([]*StoryTransition, *http.Response, error) {
+	u := fmt.Sprintf("projects/%v/stories/%v/transitions", projectID, storyID)
+	req, err := service.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var transitions []*StoryTransition
+	resp, err := service.client.Do(req, &transitions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return transitions, resp, nil
+}
+
+// ListProjectTransitions returns the state transitions of all stories in a project.
+// The occurredAfter and occurredBefore arguments are optional and can be used
+// to limit the time window the transitions are returned for.
+func (service *StoryService) ListProjectTransitions(projectID int, occurredAfter *time.Time, occurredBefore *time.Time) ([]*StoryTransition, error) {
+	reqFunc := newStoryTransitionsRequestFunc(service.client, projectID, occurredAfter, occurredBefore)
+	cursor, err := newCursor(service.client, reqFunc, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var transitions []*StoryTransition
+	if err := cursor.all(&transitions); err != nil {
+		return nil, err
+	}
+	return transitions, nil
+}
+
+func newStoryTransitionsRequestFunc(client *Client, projectID int, occurredAfter *time.Time, occurredBefore *time.Time) func() *http.Request {
+	return func() *http.Request {
+		u := fmt.Sprintf("projects/%v/story_transitions", projectID)
+		queryParams := url.Values{}
+		if occurredAfter != nil {
+			queryParams.Add("occurred_after", occurredAfter.Format(time.RFC3339))
+		}
+		if occurredBefore != nil {
+			queryParams.Add("occurred_before", occurredBefore.Format(time.RFC3339))
+		}
+		if len(queryParams) > 0 {
+			u += "?" + queryParams.Encode()
+		}
+		req, _ := client.NewRequest("GET", u, nil)
+		return req
+	}
+}
+
+// StoryTransitionCursor is used to implement the iterator pattern.
+type StoryTransitionCursor struct {
+	*cursor
+	buff []*StoryTransition
+}
+
+// Next returns the next story transition.
+//
+// In case there are no more transitions, io.EOF is returned as an error.
+func (c *StoryTransitionCursor) Next() (t *StoryTransition, err error) {
+	if len(c.buff) == 0 {
+		_, err = c.next(&c.buff)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(c.buff) == 0 {
+		err = io.EOF
+	} else {
+		t, c.buff = c.buff[0], c.buff[1:]
+	}
+	return t, err
+}
+
+// IterateProjectTransitions returns a cursor that can be used to iterate over
+// the state transitions of all stories in a project. More transitions are
+// fetched on demand as needed.
+func (service *StoryService) IterateProjectTransitions(projectID int, occurredAfter *time.Time, occurredBefore *time.Time) (c *StoryTransitionCursor, err error) {
+	reqFunc := newStoryTransitionsRequestFunc(service.client, projectID, occurredAfter, occurredBefore)
+	cursor, err := newCursor(service.client, reqFunc, PageLimit)
+	if err != nil {
+		return nil, err
+	}
+	return &StoryTransitionCursor{cursor, make([]*StoryTransition, 0)}, nil
+}
+
+// TimeInState computes how long a story spent in each of the StoryState* states.
+//
+// The transitions are expected to belong to a single story, they don't need
+// to be sorted. The last state the story transitioned into is counted until now.
+// Transitions without OccurredAt are ignored.
+func TimeInState(transitions []*StoryTransition, now time.Time) map[StoryState]time.Duration {
+	sorted := make([]*StoryTransition, 0, len(transitions))
+	for _, t := range transitions {
+		if t.OccurredAt != nil {
+			sorted = append(sorted, t)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].OccurredAt.Before(*sorted[j].OccurredAt)
+	})
+
+	durations := make(map[StoryState]time.Duration)
+	for i, t := range sorted {
+		end := now
+		if i+1 < len(sorted) {
+			end = *sorted[i+1].OccurredAt
+		}
+		if end.After(*t.OccurredAt) {
+			durations[t.State] += end.Sub(*t.OccurredAt)
+		}
+	}
+	return durations
+}
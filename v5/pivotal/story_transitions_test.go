@@ -0,0 +1,97 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTimeInState(t *testing.T) {
+	start := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hours int) *time.Time {
+		t := start.Add(time.Duration(hours) * time.Hour)
+		return &t
+	}
+	now := *at(100)
+
+	tests := []struct {
+		name        string
+		transitions []*StoryTransition
+		want        map[StoryState]time.Duration
+	}{
+		{
+			name: "no transitions",
+			want: map[StoryState]time.Duration{},
+		},
+		{
+			name: "last state counted until now",
+			transitions: []*StoryTransition{
+				{State: StoryStateStarted, OccurredAt: at(90)},
+			},
+			want: map[StoryState]time.Duration{
+				StoryStateStarted: 10 * time.Hour,
+			},
+		},
+		{
+			name: "unsorted transitions",
+			transitions: []*StoryTransition{
+				{State: StoryStateFinished, OccurredAt: at(5)},
+				{State: StoryStateStarted, OccurredAt: at(1)},
+				{State: StoryStateAccepted, OccurredAt: at(20)},
+				{State: StoryStateDelivered, OccurredAt: at(8)},
+			},
+			want: map[StoryState]time.Duration{
+				StoryStateStarted:   4 * time.Hour,
+				StoryStateFinished:  3 * time.Hour,
+				StoryStateDelivered: 12 * time.Hour,
+				StoryStateAccepted:  80 * time.Hour,
+			},
+		},
+		{
+			name: "repeated state is summed up",
+			transitions: []*StoryTransition{
+				{State: StoryStateStarted, OccurredAt: at(0)},
+				{State: StoryStateDelivered, OccurredAt: at(2)},
+				{State: StoryStateRejected, OccurredAt: at(3)},
+				{State: StoryStateStarted, OccurredAt: at(4)},
+				{State: StoryStateAccepted, OccurredAt: at(10)},
+			},
+			want: map[StoryState]time.Duration{
+				StoryStateStarted:   8 * time.Hour,
+				StoryStateDelivered: 1 * time.Hour,
+				StoryStateRejected:  1 * time.Hour,
+				StoryStateAccepted:  90 * time.Hour,
+			},
+		},
+		{
+			name: "transitions without OccurredAt are ignored",
+			transitions: []*StoryTransition{
+				{State: StoryStateStarted, OccurredAt: at(50)},
+				{State: StoryStateFinished},
+			},
+			want: map[StoryState]time.Duration{
+				StoryStateStarted: 50 * time.Hour,
+			},
+		},
+		{
+			name: "transitions in the future are not counted",
+			transitions: []*StoryTransition{
+				{State: StoryStateStarted, OccurredAt: at(110)},
+			},
+			want: map[StoryState]time.Duration{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := TimeInState(test.transitions, now)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("TimeInState() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
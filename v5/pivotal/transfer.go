@@ -0,0 +1,185 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNoRequester is returned by StoryService.Transfer when neither the requester
+// of the story nor the authenticated user is a member of the target project.
+var ErrNoRequester = errors.New("no member of the target project to request the story")
+
+// StoryTransferOptions selects what is carried over when a story is moved
+// to another project. The parts of the story that are not carried over
+// are removed from the story once it is moved.
+type StoryTransferOptions struct {
+	Tasks    bool
+	Comments bool
+	Labels   bool
+	Blockers bool
+}
+
+// StoryTransferReport describes the outcome of StoryService.Transfer.
+type StoryTransferReport struct {
+	// Story is the story as moved into the target project.
+	Story *Story
+
+	// DroppedOwnerIDs lists the owners that are not members of the target project.
+	DroppedOwnerIDs []int
+
+	// DroppedFollowerIDs lists the followers that are not members of the target project.
+	DroppedFollowerIDs []int
+
+	// DroppedRequestedByID is the original requester in case they are not
+	// a member of the target project, otherwise it is 0. The requester
+	// is replaced with the authenticated user in that case.
+	DroppedRequestedByID int
+
+	// DroppedLabels lists the names of the labels that were not carried over.
+	DroppedLabels []string
+
+	// Errors lists the failures to remove the tasks, comments and blockers
+	// that were not to be carried over.
+	Errors []error
+}
+
+// Transfer moves a story into another project.
+//
+// Labels are mapped to the labels of the target project by name, labels
+// missing in the target project are created. Owners and followers are kept
+// only when they are members of the target project. A requester who is not
+// a member is replaced with the authenticated user, ErrNoRequester is returned
+// in case the authenticated user is not a member either. In case opts is nil,
+// everything is carried over.
+//
+// The error returned is only set when the story itself could not be moved.
+// Failures to clean up the parts not carried over are listed in the report.
+func (service *StoryService) Transfer(
+	projectID int,
+	storyID int,
+	targetProjectID int,
+	opts *StoryTransferOptions,
+) (*StoryTransferReport, error) {
+
+	if targetProjectID == 0 {
+		return nil, &ErrFieldNotSet{"project_id"}
+	}
+
+	options := StoryTransferOptions{true, true, true, true}
+	if opts != nil {
+		options = *opts
+	}
+
+	story, _, err := service.Get(projectID, storyID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships, _, err := service.client.Memberships.List(targetProjectID)
+	if err != nil {
+		return nil, err
+	}
+	members := make(map[int]bool, len(memberships))
+	for _, membership := range memberships {
+		members[membership.Person.ID] = true
+	}
+
+	var (
+		report  StoryTransferReport
+		request = StoryRequest{ProjectID: &targetProjectID}
+	)
+
+	ownerIDs := make([]int, 0, len(story.OwnerIDs))
+	for _, id := range story.OwnerIDs {
+		if members[id] {
+			ownerIDs = append(ownerIDs, id)
+		} else {
+			report.DroppedOwnerIDs = append(report.DroppedOwnerIDs, id)
+		}
+	}
+	request.OwnerIDs = &ownerIDs
+
+	followerIDs := make([]int, 0, len(story.FollowerIDs))
+	for _, id := range story.FollowerIDs {
+		if members[id] {
+			followerIDs = append(followerIDs, id)
+		} else {
+			report.DroppedFollowerIDs = append(report.DroppedFollowerIDs, id)
+		}
+	}
+	request.FollowerIDs = &followerIDs
+
+	if story.RequestedByID != 0 {
+		if members[story.RequestedByID] {
+			request.RequestedByID = &story.RequestedByID
+		} else {
+			me, _, err := service.client.Me.Get()
+			if err != nil {
+				return nil, err
+			}
+			if !members[me.ID] {
+				return nil, ErrNoRequester
+			}
+			request.RequestedByID = &me.ID
+			report.DroppedRequestedByID = story.RequestedByID
+		}
+	}
+
+	labels := make([]*Label, 0, len(story.Labels))
+	for _, label := range story.Labels {
+		if options.Labels {
+			labels = append(labels, &Label{Name: label.Name})
+		} else {
+			report.DroppedLabels = append(report.DroppedLabels, label.Name)
+		}
+	}
+	request.Labels = &labels
+
+	movedStory, _, err := service.Update(projectID, storyID, &request)
+	if err != nil {
+		return nil, err
+	}
+	report.Story = movedStory
+
+	if !options.Tasks {
+		tasks, _, err := service.ListTasks(targetProjectID, storyID)
+		if err != nil {
+			report.Errors = append(report.Errors, err)
+		}
+		for _, task := range tasks {
+			if _, err := service.DeleteTask(targetProjectID, storyID, task.ID); err != nil {
+				report.Errors = append(report.Errors, fmt.Errorf("task %v: %v", task.ID, err))
+			}
+		}
+	}
+
+	if !options.Comments {
+		comments, _, err := service.ListComments(targetProjectID, storyID)
+		if err != nil {
+			report.Errors = append(report.Errors, err)
+		}
+		for _, comment := range comments {
+			if _, err := service.DeleteComment(targetProjectID, storyID, comment.ID); err != nil {
+				report.Errors = append(report.Errors, fmt.Errorf("comment %v: %v", comment.ID, err))
+			}
+		}
+	}
+
+	if !options.Blockers {
+		blockers, _, err := service.ListBlockers(targetProjectID, storyID)
+		if err != nil {
+			report.Errors = append(report.Errors, err)
+		}
+		for _, blocker := range blockers {
+			if _, err := service.DeleteBlocker(targetProjectID, storyID, blocker.ID); err != nil {
+				report.Errors = append(report.Errors, fmt.Errorf("blocker %v: %v", blocker.ID, err))
+			}
+		}
+	}
+
+	return &report, nil
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestStoryServiceTransferReplacesRequester(t *testing.T) {
+	var requestedByID interface{}
+
+	// Only person 20 is a member of the target project 3.
+	client := newTestClient(t, testRoutes(t, map[string]http.HandlerFunc{
+		"GET /projects/1/stories/2":   respondJSON(&Story{ID: 2, ProjectID: 1, RequestedByID: 10}),
+		"GET /projects/3/memberships": respondJSON([]*ProjectMembership{{Person: Person{ID: 20}}}),
+		"GET /me":                     respondJSON(&Me{ID: 20}),
+		"PUT /projects/1/stories/2": func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+			requestedByID = body["requested_by_id"]
+			respondJSON(&Story{ID: 2, ProjectID: 3})(w, r)
+		},
+	}))
+
+	report, err := client.Stories.Transfer(1, 2, 3, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requestedByID != float64(20) {
+		t.Errorf("requested_by_id = %v, want 20", requestedByID)
+	}
+	if report.DroppedRequestedByID != 10 {
+		t.Errorf("DroppedRequestedByID = %v, want 10", report.DroppedRequestedByID)
+	}
+}
+
+func TestStoryServiceTransferNoRequester(t *testing.T) {
+	// Neither the requester 10 nor the authenticated user 30 is a member of project 3.
+	client := newTestClient(t, testRoutes(t, map[string]http.HandlerFunc{
+		"GET /projects/1/stories/2":   respondJSON(&Story{ID: 2, ProjectID: 1, RequestedByID: 10}),
+		"GET /projects/3/memberships": respondJSON([]*ProjectMembership{{Person: Person{ID: 20}}}),
+		"GET /me":                     respondJSON(&Me{ID: 30}),
+		"PUT /projects/1/stories/2": func(w http.ResponseWriter, r *http.Request) {
+			t.Error("the story was moved")
+		},
+	}))
+
+	if _, err := client.Stories.Transfer(1, 2, 3, nil); err != ErrNoRequester {
+		t.Errorf("expected ErrNoRequester, got %v", err)
+	}
+}
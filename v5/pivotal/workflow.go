@@ -0,0 +1,90 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"net/http"
+)
+
+// Start moves a story into the started state. In case any owner IDs are given,
+// they replace the current owners of the story in the same request.
+//
+// Features must be estimated before they can be started.
+func (service *StoryService) Start(projectID, storyID int, ownerIDs ...int) (*Story, *http.Response, error) {
+	return service.transition(projectID, storyID, StoryStateStarted, func(story *Story, request *StoryRequest) error {
+		if story.Type == StoryTypeFeature && story.Estimate == nil {
+			return &ErrFieldNotSet{"estimate"}
+		}
+		if len(ownerIDs) != 0 {
+			request.OwnerIDs = Ints(ownerIDs...)
+		}
+		return nil
+	})
+}
+
+// Finish moves a started story into the finished state.
+// Chores are never finished, use Accept instead.
+func (service *StoryService) Finish(projectID, storyID int) (*Story, *http.Response, error) {
+	return service.transition(projectID, storyID, StoryStateFinished, nil)
+}
+
+// Deliver moves a finished story into the delivered state.
+func (service *StoryService) Deliver(projectID, storyID int) (*Story, *http.Response, error) {
+	return service.transition(projectID, storyID, StoryStateDelivered, nil)
+}
+
+// Accept moves a delivered story, or a started chore, into the accepted state.
+func (service *StoryService) Accept(projectID, storyID int) (*Story, *http.Response, error) {
+	return service.transition(projectID, storyID, StoryStateAccepted, nil)
+}
+
+// Reject moves a delivered story into the rejected state and posts
+// the reason as a comment on the story. The comment is posted only
+// after the story is rejected successfully.
+func (service *StoryService) Reject(projectID, storyID int, reason string) (*Story, *http.Response, error) {
+	if reason == "" {
+		return nil, nil, &ErrFieldNotSet{"reason"}
+	}
+
+	story, resp, err := service.transition(projectID, storyID, StoryStateRejected, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	_, resp, err = service.AddComment(projectID, storyID, &Comment{Text: reason})
+	if err != nil {
+		return story, resp, err
+	}
+
+	return story, resp, nil
+}
+
+// transition fetches the story, checks it can move into the given state,
+// lets prepare check the story and fill in the request and sends the update.
+func (service *StoryService) transition(
+	projectID int,
+	storyID int,
+	to StoryState,
+	prepare func(story *Story, request *StoryRequest) error,
+) (*Story, *http.Response, error) {
+
+	story, resp, err := service.Get(projectID, storyID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err := ValidateTransition(story.Type, story.State, to); err != nil {
+		return nil, nil, err
+	}
+
+	request := StoryRequest{State: &to}
+	if prepare != nil {
+		if err := prepare(story, &request); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return service.Update(projectID, storyID, &request)
+}
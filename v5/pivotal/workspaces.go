@@ -0,0 +1,133 @@
+// Copyright (c) 2014-2018 Salsita Software
+// Use of this source code is governed by the MIT License.
+// The license can be found in the LICENSE file.
+
+package pivotal
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Workspace is the primary data object for the WorkspaceService.
+type Workspace struct {
+	ID         int    `json:"id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	PersonID   int    `json:"person_id,omitempty"`
+	ProjectIDs []int  `json:"project_ids,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+}
+
+// WorkspaceRequest is used to do Create/Update on workspaces.
+type WorkspaceRequest struct {
+	Name       string `json:"name,omitempty"`
+	ProjectIDs *[]int `json:"project_ids,omitempty"`
+}
+
+// WorkspaceService wraps the client context for interacting with the workspaces
+// of the authenticated user.
+type WorkspaceService struct {
+	client *Client
+}
+
+func newWorkspaceService(client *Client) *WorkspaceService {
+	return &WorkspaceService{client}
+}
+
+// List returns the workspaces of the authenticated user.
+func (service *WorkspaceService) List() ([]*Workspace, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "my/workspaces", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var workspaces []*Workspace
+	resp, err := service.client.Do(req, &workspaces)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return workspaces, resp, nil
+}
+
+// Get returns a single workspace by ID.
+func (service *WorkspaceService) Get(workspaceID int) (*Workspace, *http.Response, error) {
+	u := fmt.Sprintf("my/workspaces/%v", workspaceID)
+	req, err := service.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var workspace Workspace
+	resp, err := service.client.Do(req, &workspace)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &workspace, resp, nil
+}
+
+// Create will make a new workspace.
+func (service *WorkspaceService) Create(workspace *WorkspaceRequest) (*Workspace, *http.Response, error) {
+	if workspace.Name == "" {
+		return nil, nil, &ErrFieldNotSet{"name"}
+	}
+
+	req, err := service.client.NewRequest("POST", "my/workspaces", workspace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var newWorkspace Workspace
+	resp, err := service.client.Do(req, &newWorkspace)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &newWorkspace, resp, nil
+}
+
+// Update will change the name or the projects of an existing workspace.
+func (service *WorkspaceService) Update(workspaceID int, workspace *WorkspaceRequest) (*Workspace, *http.Response, error) {
+	u := fmt.Sprintf("my/workspaces/%v", workspaceID)
+	req, err := service.client.NewRequest("PUT", u, workspace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updatedWorkspace Workspace
+	resp, err := service.client.Do(req, &updatedWorkspace)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &updatedWorkspace, resp, nil
+}
+
+// Delete will remove a workspace.
+func (service *WorkspaceService) Delete(workspaceID int) (*http.Response, error) {
+	u := fmt.Sprintf("my/workspaces/%v", workspaceID)
+	req, err := service.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.client.Do(req, nil)
+}
+
+// ListStories returns the stories matching the filter in all the projects
+// of the workspace, see StoryService.ListAcrossProjects.
+func (service *WorkspaceService) ListStories(workspaceID int, filter string) (*MultiProjectStories, error) {
+	workspace, _, err := service.Get(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if len(workspace.ProjectIDs) == 0 {
+		return &MultiProjectStories{ByProject: map[int][]*Story{}}, nil
+	}
+
+	return service.client.Stories.ListAcrossProjects(&MultiProjectQuery{
+		ProjectIDs: workspace.ProjectIDs,
+		Filter:     filter,
+	})
+}